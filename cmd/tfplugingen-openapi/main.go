@@ -38,8 +38,22 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	validateFactory := func() (cli.Command, error) {
+		return &cmd.ValidateCommand{
+			UI: ui,
+		}, nil
+	}
+
+	discoverFactory := func() (cli.Command, error) {
+		return &cmd.DiscoverCommand{
+			UI: ui,
+		}, nil
+	}
+
 	return map[string]cli.CommandFactory{
+		"discover": discoverFactory,
 		"generate": generateFactory,
+		"validate": validateFactory,
 	}
 }
 
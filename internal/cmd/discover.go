@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+type DiscoverCommand struct {
+	UI              cli.Ui
+	oasInputPath    string
+	flagOutputPath  string
+	flagSpecBaseDir string
+}
+
+func (cmd *DiscoverCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("discover", flag.ExitOnError)
+	fs.StringVar(&cmd.flagOutputPath, "output", "./generator_config.yml", "destination file path for the draft generator config (YAML)")
+	fs.StringVar(&cmd.flagSpecBaseDir, "spec-base-dir", "", "base directory for resolving relative references to local files in the OpenAPI spec, defaults to the directory of the OpenAPI spec file")
+	return fs
+}
+
+func (cmd *DiscoverCommand) Help() string {
+	return flagsHelp("tfplugingen-openapi discover [<args>] </path/to/oas_file.yml>", cmd.Flags())
+}
+
+func (cmd *DiscoverCommand) Synopsis() string {
+	return "Drafts a generator config by discovering resources and data sources in an OpenAPI 3.x or Swagger 2.0 Specification"
+}
+
+func (cmd *DiscoverCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPath = fs.Arg(0)
+	if cmd.oasInputPath == "" {
+		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	err = cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *DiscoverCommand) runInternal(logger *slog.Logger) error {
+	// 1. Read and parse OpenAPI spec file
+	model, err := buildOpenAPIModel(logger, cmd.oasInputPath, cmd.flagSpecBaseDir, nil)
+	if err != nil {
+		return err
+	}
+
+	// 2. Discover resources and data sources with the guesstimator explorer
+	discovery := explorer.DiscoverGeneratorConfig(*model)
+
+	// 3. Output draft generator config to file
+	output, err := os.Create(cmd.flagOutputPath)
+	if err != nil {
+		return fmt.Errorf("error creating output file for generator config: %w", err)
+	}
+	defer output.Close()
+
+	_, err = output.WriteString(renderDiscovery(discovery))
+	if err != nil {
+		return fmt.Errorf("error writing generator config to output: %w", err)
+	}
+
+	cmd.UI.Output(fmt.Sprintf(
+		"discovered %d resource(s) and %d data source(s), rejected %d resource candidate(s)",
+		len(discovery.Resources),
+		len(discovery.DataSources),
+		len(discovery.ResourceCandidates)-len(discovery.Resources),
+	))
+
+	return nil
+}
+
+// renderDiscovery renders a discovery as generator config YAML. Each resource is annotated with comments describing why it was
+// accepted and any naming hints. Rejected resource candidates are rendered as comments, with the reasons they were rejected, so
+// they can be completed and uncommented manually.
+func renderDiscovery(discovery explorer.Discovery) string {
+	b := &strings.Builder{}
+
+	b.WriteString("# Draft generator config created by 'tfplugingen-openapi discover', review before generating.\n")
+	b.WriteString("provider:\n")
+	fmt.Fprintf(b, "  name: %s\n", yamlScalar(discovery.Provider.Name))
+
+	var accepted, rejected []string
+	for _, name := range util.SortedKeys(discovery.ResourceCandidates) {
+		if discovery.ResourceCandidates[name].Accepted {
+			accepted = append(accepted, name)
+		} else {
+			rejected = append(rejected, name)
+		}
+	}
+
+	if len(accepted) > 0 {
+		b.WriteString("\nresources:\n")
+		for _, name := range accepted {
+			writeResourceCandidate(b, "", name, discovery.ResourceCandidates[name])
+		}
+	}
+
+	if len(discovery.DataSources) > 0 {
+		b.WriteString("\ndata_sources:\n")
+		for _, name := range util.SortedKeys(discovery.DataSources) {
+			fmt.Fprintf(b, "  %s:\n", yamlScalar(name))
+			writeLocation(b, "", "read", discovery.DataSources[name].Read)
+		}
+	}
+
+	if len(rejected) > 0 {
+		b.WriteString("\n# Rejected resource candidates, these don't follow RESTful conventions and may require manual mapping:\n")
+		b.WriteString("#\n# resources:\n")
+		for _, name := range rejected {
+			writeResourceCandidate(b, "# ", name, discovery.ResourceCandidates[name])
+		}
+	}
+
+	return b.String()
+}
+
+func writeResourceCandidate(b *strings.Builder, prefix string, name string, candidate explorer.ResourceCandidate) {
+	status := "rejected"
+	if candidate.Accepted {
+		status = "accepted"
+	}
+
+	fmt.Fprintf(b, "%s  %s:\n", prefix, yamlScalar(name))
+	for _, reason := range candidate.Reasons {
+		fmt.Fprintf(b, "%s    # %s: %s\n", prefix, status, reason)
+	}
+	if len(candidate.NameHints) > 0 {
+		fmt.Fprintf(b, "%s    # name hints: %s\n", prefix, strings.Join(candidate.NameHints, ", "))
+	}
+	writeLocation(b, prefix, "create", candidate.Resource.Create)
+	writeLocation(b, prefix, "read", candidate.Resource.Read)
+	writeLocation(b, prefix, "update", candidate.Resource.Update)
+	writeLocation(b, prefix, "delete", candidate.Resource.Delete)
+}
+
+func writeLocation(b *strings.Builder, prefix string, opName string, location *config.OpenApiSpecLocation) {
+	if location == nil {
+		return
+	}
+
+	fmt.Fprintf(b, "%s    %s:\n", prefix, opName)
+	fmt.Fprintf(b, "%s      path: %s\n", prefix, yamlScalar(location.Path))
+	fmt.Fprintf(b, "%s      method: %s\n", prefix, yamlScalar(location.Method))
+}
+
+// yamlScalar quotes a string value if required to be a valid YAML scalar.
+func yamlScalar(value string) string {
+	bytes, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%q", value)
+	}
+
+	return strings.TrimSuffix(string(bytes), "\n")
+}
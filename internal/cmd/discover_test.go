@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+func TestDiscover(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath    string
+		goldenFilePath string
+	}{
+		"Swagger Petstore - OpenAPI 3.0": {
+			oasSpecPath:    "testdata/petstore3/openapi_spec.json",
+			goldenFilePath: "testdata/discover/petstore3_generator_config.yml",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempGeneratorConfigPath := path.Join(t.TempDir(), "generator_config.yml")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.DiscoverCommand{UI: mockUi}
+			args := []string{
+				"--output", tempGeneratorConfigPath,
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running discover cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempGeneratorConfigBytes, err := os.ReadFile(tempGeneratorConfigPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(tempGeneratorConfigBytes), string(goldenFileBytes)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+func TestHelp_EmptyDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		command         cli.Command
+		expectedDefault string
+	}{
+		"discover": {
+			command:         &cmd.DiscoverCommand{UI: cli.NewMockUi()},
+			expectedDefault: `(default: "./generator_config.yml")`,
+		},
+		"generate": {
+			command:         &cmd.GenerateCommand{UI: cli.NewMockUi()},
+			expectedDefault: `(default: "./generator_config.yml")`,
+		},
+		"validate": {
+			command:         &cmd.ValidateCommand{UI: cli.NewMockUi()},
+			expectedDefault: `(default: "./generator_config.yml")`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			help := testCase.command.Help()
+
+			if strings.Contains(help, `(default: "")`) {
+				t.Errorf("expected no empty defaults, got: %s", help)
+			}
+
+			if !strings.Contains(help, testCase.expectedDefault) {
+				t.Errorf("expected %s, got: %s", testCase.expectedDefault, help)
+			}
+		})
+	}
+}
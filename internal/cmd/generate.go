@@ -9,74 +9,70 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
-	"strings"
+	"path/filepath"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/swagger"
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/cli"
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/index"
 )
 
 type GenerateCommand struct {
-	UI             cli.Ui
-	oasInputPath   string
-	flagConfigPath string
-	flagOutputPath string
+	UI              cli.Ui
+	oasInputPaths   []string
+	flagConfigPath  string
+	flagOverlays    stringSliceFlag
+	flagOutputPath  string
+	flagStrict      bool
+	flagExplorer    string
+	flagSpecBaseDir string
+	flagOASOverlays stringSliceFlag
 }
 
+const (
+	// explorerConfig finds resources and data sources from the generator config
+	explorerConfig = "config"
+
+	// explorerExtensions finds resources and data sources from `x-terraform-*` vendor extensions in the OpenAPI spec
+	explorerExtensions = "extensions"
+
+	// explorerConfigExtensions finds resources and data sources from the generator config, layered on top of the vendor extensions
+	explorerConfigExtensions = "config+extensions"
+
+	// explorerGuesstimator finds resources, data sources, and the provider schema by guessing from the paths and security schemes in the OpenAPI spec
+	explorerGuesstimator = "guesstimator"
+)
+
 func (cmd *GenerateCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
+	fs.Var(&cmd.flagOverlays, "config-overlay", "path to a generator config overlay file (YAML) that patches the generator config, can be passed multiple times")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./provider_code_spec.json", "destination file path for generated provider code spec (JSON)")
+	fs.BoolVar(&cmd.flagStrict, "strict", false, "fail if any ignores or overrides in the generator config don't match an attribute")
+	fs.StringVar(&cmd.flagSpecBaseDir, "spec-base-dir", "", "base directory for resolving relative references to local files in the OpenAPI spec, defaults to the directory of the OpenAPI spec file")
+	fs.Var(&cmd.flagOASOverlays, "overlay", "path to an OpenAPI Overlay document applied to the OpenAPI spec before mapping, can be passed multiple times. Use '<alias>=<path>' to apply it to a specific OpenAPI spec")
+	fs.StringVar(&cmd.flagExplorer, "explorer", explorerConfig, "how resources and data sources are found: 'config', 'extensions' (vendor extensions in the OpenAPI spec), 'config+extensions', or 'guesstimator' (guessed from the OpenAPI spec)")
 	return fs
 }
 
 func (cmd *GenerateCommand) Help() string {
-	strBuilder := &strings.Builder{}
-
-	longestName := 0
-	longestUsage := 0
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
-		if len(f.Name) > longestName {
-			longestName = len(f.Name)
-		}
-		if len(f.Usage) > longestUsage {
-			longestUsage = len(f.Usage)
-		}
-	})
-
-	strBuilder.WriteString("\nUsage: tfplugingen-openapi generate [<args>] </path/to/oas_file.yml>\n\n")
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
-		if f.DefValue != "" {
-			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
-				f.Name,
-				strings.Repeat(" ", longestName-len(f.Name)+2),
-				f.Usage,
-				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
-				f.DefValue,
-			))
-		} else {
-			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
-				f.Name,
-				strings.Repeat(" ", longestName-len(f.Name)+2),
-				f.Usage,
-				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
-			))
-		}
-	})
-	strBuilder.WriteString("\n")
-
-	return strBuilder.String()
+	return flagsHelp("tfplugingen-openapi generate [<args>] [<alias>=]</path/to/oas_file.yml> [[<alias>=]</path/to/another_oas_file.yml> ...]", cmd.Flags())
 }
 
 func (cmd *GenerateCommand) Synopsis() string {
-	return "Generates Provider Code Specification from an OpenAPI 3.x Specification"
+	return "Generates Provider Code Specification from an OpenAPI 3.x or Swagger 2.0 Specification"
 }
 
 func (cmd *GenerateCommand) Run(args []string) int {
@@ -91,8 +87,8 @@ func (cmd *GenerateCommand) Run(args []string) int {
 		return 1
 	}
 
-	cmd.oasInputPath = fs.Arg(0)
-	if cmd.oasInputPath == "" {
+	cmd.oasInputPaths = fs.Args()
+	if len(cmd.oasInputPaths) == 0 {
 		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
 		return 1
 	}
@@ -107,59 +103,92 @@ func (cmd *GenerateCommand) Run(args []string) int {
 }
 
 func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
-	// 1. Read and parse generator config file
-	configBytes, err := os.ReadFile(cmd.flagConfigPath)
-	if err != nil {
-		return fmt.Errorf("error reading generator config file: %w", err)
+	// 1. Read and parse generator config file, which is not required when only using vendor extensions or the guesstimator
+	var cfg *config.Config
+	switch cmd.flagExplorer {
+	case explorerConfig, explorerConfigExtensions:
+		configBytes, err := config.ReadConfig(cmd.flagConfigPath, cmd.flagOverlays)
+		if err != nil {
+			return fmt.Errorf("error reading generator config file: %w", err)
+		}
+		cfg, err = config.ParseConfig(configBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing generator config file: %w", err)
+		}
+	case explorerExtensions, explorerGuesstimator:
+		cfg = &config.Config{}
+	default:
+		return fmt.Errorf("invalid explorer %q - must be '%s', '%s', '%s', or '%s'", cmd.flagExplorer, explorerConfig, explorerExtensions, explorerConfigExtensions, explorerGuesstimator)
 	}
-	config, err := config.ParseConfig(configBytes)
-	if err != nil {
-		return fmt.Errorf("error parsing generator config file: %w", err)
+	if cmd.flagStrict {
+		cfg.Options.Strict = true
 	}
 
-	// 2. Read and parse OpenAPI spec file
-	oasBytes, err := os.ReadFile(cmd.oasInputPath)
+	// 2. Read and parse OpenAPI spec files, the first OpenAPI spec is the default for generator config entries without a `spec`
+	specInputs, err := parseSpecInputs(cmd.oasInputPaths)
 	if err != nil {
-		return fmt.Errorf("error reading OpenAPI spec file: %w", err)
+		return err
 	}
-	doc, err := libopenapi.NewDocument(oasBytes)
-	if err != nil {
-		return fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+
+	aliases := make([]string, 0, len(specInputs))
+	for _, specInput := range specInputs {
+		aliases = append(aliases, specInput.alias)
+	}
+	if err := cfg.ValidateSpecAliases(aliases); err != nil {
+		return fmt.Errorf("error validating generator config: %w", err)
 	}
 
-	// 3. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model
-	model, errs := doc.BuildV3Model()
+	overlayPaths, err := parseOverlayInputs(cmd.flagOASOverlays, specInputs)
+	if err != nil {
+		return err
+	}
 
-	// 4. Log circular references as warnings and fail on any other model building errors
-	var errResult error
-	for _, err := range errs {
-		if rslvErr, ok := err.(*index.ResolvingError); ok {
-			logger.Warn(
-				"circular reference found in OpenAPI spec",
-				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
-			continue
+	specs := make([]specModel, 0, len(specInputs))
+	for _, specInput := range specInputs {
+		model, err := buildOpenAPIModel(logger, specInput.path, cmd.flagSpecBaseDir, overlayPaths[specInput.alias])
+		if err != nil {
+			return fmt.Errorf("OpenAPI spec %q: %w", specInput.alias, err)
 		}
 
-		errResult = errors.Join(errResult, err)
+		specs = append(specs, specModel{alias: specInput.alias, model: model})
+	}
+
+	if len(specs) > 1 {
+		logComponentCollisions(logger, specs)
 	}
-	if errResult != nil {
-		return fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+
+	// 3. Generate provider code spec w/ an explorer for each OpenAPI spec
+	explorers := make([]explorer.Explorer, 0, len(specs))
+	for i, oasSpec := range specs {
+		specConfig := cfg.ForSpec(oasSpec.alias, i == 0)
+
+		switch cmd.flagExplorer {
+		case explorerExtensions:
+			explorers = append(explorers, explorer.NewExtensionExplorer(*oasSpec.model))
+		case explorerConfigExtensions:
+			explorers = append(explorers, explorer.NewLayeredExplorer(
+				explorer.NewConfigExplorer(*oasSpec.model, specConfig),
+				explorer.NewExtensionExplorer(*oasSpec.model),
+			))
+		case explorerGuesstimator:
+			explorers = append(explorers, explorer.NewGuesstimatorExplorer(*oasSpec.model))
+		default:
+			explorers = append(explorers, explorer.NewConfigExplorer(*oasSpec.model, specConfig))
+		}
 	}
 
-	// 5. Generate provider code spec w/ config
-	oasExplorer := explorer.NewConfigExplorer(model.Model, *config)
-	providerCodeSpec, err := generateProviderCodeSpec(logger, oasExplorer, *config)
+	providerCodeSpec, err := generateProviderCodeSpec(logger, explorers, *cfg)
 	if err != nil {
 		return err
 	}
 
-	// 6. Use provider code spec to create JSON
+	// 4. Use provider code spec to create JSON
 	bytes, err := json.MarshalIndent(providerCodeSpec, "", "\t")
 	if err != nil {
 		return fmt.Errorf("error marshalling provider code spec to JSON: %w", err)
 	}
 
-	// 7. Log a warning if the provider code spec is not valid based on the JSON schema
+	// 5. Log a warning if the provider code spec is not valid based on the JSON schema
 	err = spec.Validate(context.TODO(), bytes)
 	if err != nil {
 		logger.Warn(
@@ -167,7 +196,7 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 			"validation_msg", err)
 	}
 
-	// 8. Output to file
+	// 6. Output to file
 	output, err := os.Create(cmd.flagOutputPath)
 	if err != nil {
 		return fmt.Errorf("error creating output file for provider code spec: %w", err)
@@ -181,23 +210,146 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 	return nil
 }
 
-func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg config.Config) (*spec.Specification, error) {
-	// 1. Find TF resources in OAS
-	explorerResources, err := dora.FindResources()
+// buildOpenAPIModel reads and builds the OpenAPI spec file. Relative references to local files are resolved from the spec base
+// directory, which defaults to the directory of the OpenAPI spec file. Any OpenAPI Overlay documents are applied to the parsed
+// OpenAPI spec before building the model.
+func buildOpenAPIModel(logger *slog.Logger, oasInputPath string, specBaseDir string, overlayPaths []string) (*high.Document, error) {
+	// 1. Read and parse OpenAPI spec file
+	oasBytes, err := os.ReadFile(oasInputPath)
 	if err != nil {
-		return nil, fmt.Errorf("error finding resource(s): %w", err)
+		return nil, fmt.Errorf("error reading OpenAPI spec file: %w", err)
 	}
 
-	// 2. Find TF data sources in OAS
-	explorerDataSources, err := dora.FindDataSources()
+	if specBaseDir == "" {
+		specBaseDir = filepath.Dir(oasInputPath)
+	}
+	specBaseDir, err = filepath.Abs(specBaseDir)
 	if err != nil {
-		return nil, fmt.Errorf("error finding data source(s): %w", err)
+		return nil, fmt.Errorf("error resolving OpenAPI spec base directory: %w", err)
 	}
 
-	// 3. Find TF provider in OAS
-	explorerProvider, err := dora.FindProvider()
+	doc, err := libopenapi.NewDocumentWithConfiguration(oasBytes, &datamodel.DocumentConfiguration{
+		BasePath:            specBaseDir,
+		SpecFilePath:        filepath.Base(oasInputPath),
+		AllowFileReferences: true,
+		// Errors are returned when building the model, with the file and line of any unresolvable references
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error finding provider: %w", err)
+		return nil, fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+	}
+
+	if err := applyOverlays(logger, doc.GetSpecInfo().RootNode, overlayPaths); err != nil {
+		return nil, err
+	}
+
+	// 2. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model.
+	// Swagger 2.0 models are converted into OpenAPI 3.x models, so they can be explored and mapped the same way.
+	if doc.GetSpecInfo().SpecFormat == datamodel.OAS2 {
+		model, errs := doc.BuildV2Model()
+		if err := modelBuildingErrors(logger, doc.GetRolodex(), specBaseDir, errs); err != nil {
+			return nil, fmt.Errorf("error building Swagger 2.0 model: %w", err)
+		}
+
+		return swagger.ConvertDocument(model), nil
+	}
+
+	model, errs := doc.BuildV3Model()
+	if err := modelBuildingErrors(logger, doc.GetRolodex(), specBaseDir, errs); err != nil {
+		return nil, fmt.Errorf("error building OpenAPI 3.x model: %w", err)
+	}
+
+	return &model.Model, nil
+}
+
+// modelBuildingErrors logs circular references as warnings and joins any other model building errors. Unresolvable references
+// are reported with the file and line they were found at.
+func modelBuildingErrors(logger *slog.Logger, rolodex *index.Rolodex, specBaseDir string, errs []error) error {
+	var errResult error
+	for _, err := range errs {
+		if rslvErr, ok := err.(*index.ResolvingError); ok && rslvErr.CircularReference != nil {
+			logger.Warn(
+				"circular reference found in OpenAPI spec",
+				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
+			continue
+		}
+
+		if refErr := newReferenceError(rolodex, specBaseDir, err); refErr != nil {
+			errResult = errors.Join(errResult, refErr)
+			continue
+		}
+
+		errResult = errors.Join(errResult, err)
+	}
+
+	return errResult
+}
+
+// providerCodeSpec is the generated provider code spec, which has the same JSON structure as spec.Specification. Resources also contain
+// information that isn't part of the resource schema, like the identity attribute, which the provider code spec JSON schema allows.
+type providerCodeSpec struct {
+	DataSources datasource.DataSources `json:"datasources,omitempty"`
+	Provider    *provider.Provider     `json:"provider,omitempty"`
+	Resources   []mapper.Resource      `json:"resources,omitempty"`
+	Version     string                 `json:"version,omitempty"`
+}
+
+// generateProviderCodeSpec assembles one provider code spec from the resources, data sources, and provider found by each explorer.
+// Resource and data source names must be unique across all explorers. The provider is taken from the first explorer that found a
+// provider schema, falling back to the first explorer.
+func generateProviderCodeSpec(logger *slog.Logger, explorers []explorer.Explorer, cfg config.Config) (*providerCodeSpec, error) {
+	explorerResources := map[string]explorer.Resource{}
+	explorerDataSources := map[string]explorer.DataSource{}
+	var explorerProvider *explorer.Provider
+
+	for _, dora := range explorers {
+		// 1. Find TF resources in OAS
+		resources, err := dora.FindResources()
+		if err != nil {
+			return nil, fmt.Errorf("error finding resource(s): %w", err)
+		}
+
+		var errResult error
+		for name, resource := range resources {
+			if _, ok := explorerResources[name]; ok {
+				errResult = errors.Join(errResult, fmt.Errorf("resource '%s' found in multiple OpenAPI specs", name))
+				continue
+			}
+			explorerResources[name] = resource
+		}
+		if errResult != nil {
+			return nil, fmt.Errorf("error finding resource(s): %w", errResult)
+		}
+
+		// 2. Find TF data sources in OAS
+		dataSources, err := dora.FindDataSources()
+		if err != nil {
+			return nil, fmt.Errorf("error finding data source(s): %w", err)
+		}
+
+		for name, dataSource := range dataSources {
+			if _, ok := explorerDataSources[name]; ok {
+				errResult = errors.Join(errResult, fmt.Errorf("data source '%s' found in multiple OpenAPI specs", name))
+				continue
+			}
+			explorerDataSources[name] = dataSource
+		}
+		if errResult != nil {
+			return nil, fmt.Errorf("error finding data source(s): %w", errResult)
+		}
+
+		// 3. Find TF provider in OAS
+		provider, err := dora.FindProvider()
+		if err != nil {
+			return nil, fmt.Errorf("error finding provider: %w", err)
+		}
+
+		if explorerProvider == nil || (explorerProvider.SchemaProxy == nil && provider.SchemaProxy != nil) {
+			explorerProvider = &provider
+		}
+	}
+	if explorerProvider == nil {
+		explorerProvider = &explorer.Provider{}
 	}
 
 	// 4. Use TF info to generate provider code spec for resources
@@ -215,13 +367,13 @@ func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg c
 	}
 
 	// 6. Use TF info to generate provider code spec for provider
-	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg)
+	providerMapper := mapper.NewProviderMapper(*explorerProvider, cfg)
 	providerIR, err := providerMapper.MapToIR(logger)
 	if err != nil {
 		return nil, fmt.Errorf("error generating provider code spec for provider: %w", err)
 	}
 
-	return &spec.Specification{
+	return &providerCodeSpec{
 		Version:     spec.Version0_1,
 		Provider:    providerIR,
 		Resources:   resourcesIR,
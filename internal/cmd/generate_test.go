@@ -47,6 +47,37 @@ func TestGenerate_WithConfig(t *testing.T) {
 			configPath:     "testdata/kubernetes/generator_config.yml",
 			goldenFilePath: "testdata/kubernetes/provider_code_spec.json",
 		},
+		"Widget API - Multi-file": {
+			oasSpecPath:    "testdata/multifile/openapi_spec.yml",
+			configPath:     "testdata/multifile/generator_config.yml",
+			goldenFilePath: "testdata/multifile/provider_code_spec.json",
+		},
+		// Both specs describe the same API and share a golden file, to verify parity of the Swagger 2.0 conversion
+		"Widget Store - Swagger 2.0": {
+			oasSpecPath:    "testdata/swagger2/openapi_spec.yml",
+			configPath:     "testdata/swagger2/generator_config.yml",
+			goldenFilePath: "testdata/swagger2/provider_code_spec.json",
+		},
+		"Widget Store - OpenAPI 3.0": {
+			oasSpecPath:    "testdata/swagger2/openapi_spec_v3.yml",
+			configPath:     "testdata/swagger2/generator_config.yml",
+			goldenFilePath: "testdata/swagger2/provider_code_spec.json",
+		},
+		"Swagger Petstore - Schema Defaults": {
+			oasSpecPath:    "testdata/petstore3/openapi_spec.json",
+			configPath:     "testdata/defaults/generator_config.yml",
+			goldenFilePath: "testdata/defaults/provider_code_spec.json",
+		},
+		"Swagger Petstore - Aliases": {
+			oasSpecPath:    "testdata/petstore3/openapi_spec.json",
+			configPath:     "testdata/aliases/generator_config.yml",
+			goldenFilePath: "testdata/aliases/provider_code_spec.json",
+		},
+		"Swagger Petstore - Identity": {
+			oasSpecPath:    "testdata/petstore3/openapi_spec.json",
+			configPath:     "testdata/identity/generator_config.yml",
+			goldenFilePath: "testdata/identity/provider_code_spec.json",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -84,3 +115,286 @@ func TestGenerate_WithConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerate_WithExplorer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath    string
+		explorer       string
+		configPath     string
+		goldenFilePath string
+	}{
+		"Gadget API - guesstimator": {
+			oasSpecPath:    "testdata/guesstimator/openapi_spec.yml",
+			explorer:       "guesstimator",
+			goldenFilePath: "testdata/guesstimator/provider_code_spec.json",
+		},
+		"Widget API - vendor extensions": {
+			oasSpecPath:    "testdata/extensions/openapi_spec.yml",
+			explorer:       "extensions",
+			goldenFilePath: "testdata/extensions/provider_code_spec.json",
+		},
+		"Widget API - config layered on vendor extensions": {
+			oasSpecPath:    "testdata/extensions/openapi_spec.yml",
+			explorer:       "config+extensions",
+			configPath:     "testdata/extensions/generator_config.yml",
+			goldenFilePath: "testdata/extensions/provider_code_spec_layered.json",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempProviderSpecPath := path.Join(t.TempDir(), "provider_code_spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateCommand{UI: mockUi}
+			args := []string{
+				"--explorer", testCase.explorer,
+				"--output", tempProviderSpecPath,
+			}
+			if testCase.configPath != "" {
+				args = append(args, "--config", testCase.configPath)
+			}
+			args = append(args, testCase.oasSpecPath)
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running generate cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempProviderSpecBytes, err := os.ReadFile(tempProviderSpecPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tempProviderSpecBytes, goldenFileBytes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_WithMultipleSpecs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPaths   []string
+		configPath     string
+		goldenFilePath string
+		expectedErr    bool
+	}{
+		"default aliases": {
+			oasSpecPaths:   []string{"testdata/multispec/users.yml", "testdata/multispec/billing.yml"},
+			configPath:     "testdata/multispec/generator_config.yml",
+			goldenFilePath: "testdata/multispec/provider_code_spec.json",
+		},
+		"explicit aliases": {
+			oasSpecPaths:   []string{"users=testdata/multispec/users.yml", "billing=testdata/multispec/billing.yml"},
+			configPath:     "testdata/multispec/generator_config.yml",
+			goldenFilePath: "testdata/multispec/provider_code_spec.json",
+		},
+		"unknown alias": {
+			oasSpecPaths: []string{"testdata/multispec/users.yml", "payments=testdata/multispec/billing.yml"},
+			configPath:   "testdata/multispec/generator_config.yml",
+			expectedErr:  true,
+		},
+		"duplicate alias": {
+			oasSpecPaths: []string{"testdata/multispec/users.yml", "users=testdata/multispec/billing.yml"},
+			configPath:   "testdata/multispec/generator_config.yml",
+			expectedErr:  true,
+		},
+		"wrong default spec": {
+			oasSpecPaths: []string{"testdata/multispec/billing.yml", "testdata/multispec/users.yml"},
+			configPath:   "testdata/multispec/generator_config.yml",
+			expectedErr:  true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempProviderSpecPath := path.Join(t.TempDir(), "provider_code_spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateCommand{UI: mockUi}
+			args := append([]string{
+				"--config", testCase.configPath,
+				"--output", tempProviderSpecPath,
+			}, testCase.oasSpecPaths...)
+
+			exitCode := c.Run(args)
+			if testCase.expectedErr {
+				if exitCode == 0 {
+					t.Fatal("expected generate cmd to fail, but it succeeded")
+				}
+				return
+			}
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running generate cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempProviderSpecBytes, err := os.ReadFile(tempProviderSpecPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tempProviderSpecBytes, goldenFileBytes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_WithConfigIncludes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configPath     string
+		overlayPaths   []string
+		goldenFilePath string
+		expectedErr    bool
+	}{
+		"includes": {
+			configPath:     "testdata/includes/generator_config.yml",
+			goldenFilePath: "testdata/petstore3/provider_code_spec.json",
+		},
+		"includes with overlay": {
+			configPath:     "testdata/includes/generator_config.yml",
+			overlayPaths:   []string{"testdata/includes/overlays/basic_tier.yml"},
+			goldenFilePath: "testdata/includes/provider_code_spec_basic_tier.json",
+		},
+		"include conflict": {
+			configPath:  "testdata/includes/conflict/generator_config.yml",
+			expectedErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempProviderSpecPath := path.Join(t.TempDir(), "provider_code_spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateCommand{UI: mockUi}
+			args := []string{
+				"--config", testCase.configPath,
+				"--output", tempProviderSpecPath,
+			}
+			for _, overlayPath := range testCase.overlayPaths {
+				args = append(args, "--config-overlay", overlayPath)
+			}
+			args = append(args, "testdata/petstore3/openapi_spec.json")
+
+			exitCode := c.Run(args)
+			if testCase.expectedErr {
+				if exitCode == 0 {
+					t.Fatal("expected generate cmd to fail, but it succeeded")
+				}
+				return
+			}
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running generate cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempProviderSpecBytes, err := os.ReadFile(tempProviderSpecPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tempProviderSpecBytes, goldenFileBytes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_WithOverlay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overlayPaths   []string
+		goldenFilePath string
+		expectedErr    bool
+	}{
+		"default spec": {
+			overlayPaths:   []string{"testdata/overlay/openapi_overlay.yml"},
+			goldenFilePath: "testdata/overlay/provider_code_spec.json",
+		},
+		"aliased spec": {
+			overlayPaths:   []string{"openapi_spec=testdata/overlay/openapi_overlay.yml"},
+			goldenFilePath: "testdata/overlay/provider_code_spec.json",
+		},
+		"unknown alias": {
+			overlayPaths: []string{"petstore=testdata/overlay/openapi_overlay.yml"},
+			expectedErr:  true,
+		},
+		"invalid overlay": {
+			overlayPaths: []string{"testdata/petstore3/generator_config.yml"},
+			expectedErr:  true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempProviderSpecPath := path.Join(t.TempDir(), "provider_code_spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateCommand{UI: mockUi}
+			args := []string{
+				"--config", "testdata/petstore3/generator_config.yml",
+				"--output", tempProviderSpecPath,
+			}
+			for _, overlayPath := range testCase.overlayPaths {
+				args = append(args, "--overlay", overlayPath)
+			}
+			args = append(args, "testdata/petstore3/openapi_spec.json")
+
+			exitCode := c.Run(args)
+			if testCase.expectedErr {
+				if exitCode == 0 {
+					t.Fatal("expected generate cmd to fail, but it succeeded")
+				}
+				return
+			}
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running generate cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempProviderSpecBytes, err := os.ReadFile(tempProviderSpecPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tempProviderSpecBytes, goldenFileBytes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
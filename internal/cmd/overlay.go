@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath"
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath/config"
+	"github.com/speakeasy-api/jsonpath/pkg/overlay"
+	"gopkg.in/yaml.v3"
+)
+
+// parseOverlayInputs groups OpenAPI Overlay arguments, either `<alias>=<path>` or `<path>`, by the alias of the OpenAPI spec they
+// apply to. Overlays without an alias apply to the default OpenAPI spec.
+func parseOverlayInputs(args []string, specInputs []specInput) (map[string][]string, error) {
+	overlays := map[string][]string{}
+	if len(args) == 0 {
+		return overlays, nil
+	}
+
+	aliases := make([]string, 0, len(specInputs))
+	for _, input := range specInputs {
+		aliases = append(aliases, input.alias)
+	}
+
+	for _, arg := range args {
+		alias, path, ok := splitAlias(arg)
+		if !ok {
+			alias = aliases[0]
+			path = arg
+		}
+
+		if path == "" {
+			return nil, fmt.Errorf("invalid OpenAPI overlay argument %q - must be '<path>' or '<alias>=<path>'", arg)
+		}
+
+		if !slices.Contains(aliases, alias) {
+			return nil, fmt.Errorf("OpenAPI overlay %q has an unknown spec alias: %q - must be one of %q", path, alias, aliases)
+		}
+
+		overlays[alias] = append(overlays[alias], path)
+	}
+
+	return overlays, nil
+}
+
+// applyOverlays applies each OpenAPI Overlay document, in order, to the root node of a parsed OpenAPI spec. A warning is logged for
+// each action with a target that doesn't match any node, as it's likely out of date with the OpenAPI spec.
+func applyOverlays(logger *slog.Logger, root *yaml.Node, overlayPaths []string) error {
+	for _, overlayPath := range overlayPaths {
+		oasOverlay, err := overlay.Parse(overlayPath)
+		if err != nil {
+			return fmt.Errorf("error reading OpenAPI overlay file: %w", err)
+		}
+
+		if err := oasOverlay.Validate(); err != nil {
+			return fmt.Errorf("invalid OpenAPI overlay %q: %w", overlayPath, err)
+		}
+
+		for i, action := range oasOverlay.Actions {
+			target, err := jsonpath.NewPath(action.Target, config.WithPropertyNameExtension())
+			if err != nil {
+				return fmt.Errorf("invalid OpenAPI overlay %q: action at index %d has an invalid target %q: %w", overlayPath, i, action.Target, err)
+			}
+
+			if len(target.Query(root)) == 0 {
+				logger.Warn(
+					"overlay action target did not match any nodes",
+					"overlay", overlayPath,
+					"target", action.Target)
+				continue
+			}
+
+			// Actions are applied one at a time, so each action's target is matched against the result of the previous actions
+			singleAction := overlay.Overlay{Actions: []overlay.Action{action}}
+			if err := singleAction.ApplyTo(root); err != nil {
+				return fmt.Errorf("error applying OpenAPI overlay %q: action at index %d: %w", overlayPath, i, err)
+			}
+		}
+	}
+
+	return nil
+}
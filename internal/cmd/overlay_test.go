@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v3"
+)
+
+const testOverlaySpec = `openapi: 3.1.0
+components:
+  schemas:
+    Thing:
+      type: object
+      required:
+        - name
+      properties:
+        name:
+          type: string
+        secret:
+          type: string
+`
+
+func TestApplyOverlays(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overlays         []string
+		expectedSpec     string
+		expectedWarnings []string
+		expectedErr      string // expected to be contained in the error message
+	}{
+		"update and remove": {
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Fixes
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.Thing.properties.secret
+    update:
+      format: password
+  - target: $.components.schemas.Thing.required
+    remove: true
+`},
+			expectedSpec: `openapi: 3.1.0
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+        secret:
+          type: string
+          format: password
+`,
+		},
+		"applied in order": {
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Remove required
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.Thing.required
+    remove: true
+`, `overlay: 1.0.0
+info:
+  title: Add required
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.Thing
+    update:
+      required:
+        - secret
+`},
+			expectedSpec: `openapi: 3.1.0
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+        secret:
+          type: string
+      required:
+        - secret
+`,
+		},
+		"unmatched target": {
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Outdated fixes
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.Missing
+    remove: true
+`},
+			expectedSpec:     testOverlaySpec,
+			expectedWarnings: []string{`msg="overlay action target did not match any nodes" overlay=overlay_0.yml target=$.components.schemas.Missing`},
+		},
+		"invalid overlay": {
+			overlays: []string{`overlay: 1.0.0
+actions: []
+`},
+			expectedErr: "overlay info title must be defined",
+		},
+		"invalid target": {
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Invalid
+  version: 1.0.0
+actions:
+  - target: $.components[
+    remove: true
+`},
+			expectedErr: "action at index 0 has an invalid target",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			overlayPaths := make([]string, 0, len(testCase.overlays))
+			for i, overlay := range testCase.overlays {
+				overlayPath := filepath.Join(dir, fmt.Sprintf("overlay_%d.yml", i))
+				if err := os.WriteFile(overlayPath, []byte(overlay), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				overlayPaths = append(overlayPaths, overlayPath)
+			}
+
+			var root yaml.Node
+			if err := yaml.Unmarshal([]byte(testOverlaySpec), &root); err != nil {
+				t.Fatal(err)
+			}
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey || a.Key == slog.LevelKey {
+						return slog.Attr{}
+					}
+					if a.Key == "overlay" {
+						return slog.String(a.Key, filepath.Base(a.Value.String()))
+					}
+					return a
+				},
+			}))
+
+			err := applyOverlays(logger, &root, overlayPaths)
+			if testCase.expectedErr != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedErr)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Errorf("expected error to contain %q, got: %s", testCase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotSpec bytes.Buffer
+			encoder := yaml.NewEncoder(&gotSpec)
+			encoder.SetIndent(2)
+			if err := encoder.Encode(&root); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(gotSpec.String(), testCase.expectedSpec); diff != "" {
+				t.Errorf("unexpected spec difference: %s", diff)
+			}
+
+			var gotWarnings []string
+			for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+				if line != "" {
+					gotWarnings = append(gotWarnings, line)
+				}
+			}
+
+			if diff := cmp.Diff(gotWarnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected warnings difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// referenceError is a reference in an OpenAPI spec that could not be resolved, along with the file and line it was found at.
+type referenceError struct {
+	file   string
+	line   int
+	column int
+	ref    string
+	err    error
+}
+
+func (e *referenceError) Error() string {
+	location := e.file
+	if e.line > 0 {
+		location = fmt.Sprintf("%s:%d:%d", e.file, e.line, e.column)
+	}
+
+	if e.ref == "" {
+		return fmt.Sprintf("%s: unable to resolve reference: %s", location, e.err)
+	}
+
+	return fmt.Sprintf("%s: unable to resolve reference '%s': %s", location, e.ref, e.err)
+}
+
+func (e *referenceError) Unwrap() error {
+	return e.err
+}
+
+// newReferenceError returns a referenceError if err is an indexing or resolving error, otherwise returns nil. The file is
+// found by searching every file loaded in the rolodex for the node of the reference, relative to the spec base directory.
+func newReferenceError(rolodex *index.Rolodex, specBaseDir string, err error) *referenceError {
+	var node *yaml.Node
+
+	var indexingErr *index.IndexingError
+	var resolvingErr *index.ResolvingError
+	switch {
+	case errors.As(err, &indexingErr):
+		node = indexingErr.KeyNode
+		if node == nil {
+			node = indexingErr.Node
+		}
+	case errors.As(err, &resolvingErr):
+		node = resolvingErr.Node
+	default:
+		return nil
+	}
+
+	refErr := &referenceError{
+		file: "<unknown file>",
+		err:  err,
+	}
+
+	if node == nil {
+		return refErr
+	}
+
+	refErr.line = node.Line
+	refErr.column = node.Column
+
+	file, parent := findNode(rolodex, node)
+	refErr.ref = referenceValue(node, parent)
+
+	if file != "" {
+		refErr.file = file
+		if relFile, err := filepath.Rel(specBaseDir, file); err == nil {
+			refErr.file = relFile
+		}
+	}
+
+	return refErr
+}
+
+// referenceValue returns the `$ref` value of a node, which is either the reference string, the `$ref` key in the parent
+// mapping, or a mapping with a `$ref` key.
+func referenceValue(node *yaml.Node, parent *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode && node.Value != "$ref" {
+		return node.Value
+	}
+
+	if node.Kind == yaml.ScalarNode {
+		node = parent
+	}
+
+	if node != nil && node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "$ref" {
+				return node.Content[i+1].Value
+			}
+		}
+	}
+
+	return ""
+}
+
+// findNode returns the absolute path of the file in the rolodex that contains the node, along with the parent of the node. Returns
+// an empty string if not found.
+func findNode(rolodex *index.Rolodex, node *yaml.Node) (string, *yaml.Node) {
+	if rolodex == nil {
+		return "", nil
+	}
+
+	indexes := rolodex.GetIndexes()
+	if rootIndex := rolodex.GetRootIndex(); rootIndex != nil {
+		indexes = append([]*index.SpecIndex{rootIndex}, indexes...)
+	}
+
+	for _, idx := range indexes {
+		if idx == nil {
+			continue
+		}
+
+		if found, parent := findParentNode(idx.GetRootNode(), node); found {
+			return idx.GetSpecAbsolutePath(), parent
+		}
+	}
+
+	return "", nil
+}
+
+// findParentNode searches the tree for the node, returning true and the parent of the node if found.
+func findParentNode(tree *yaml.Node, node *yaml.Node) (bool, *yaml.Node) {
+	if tree == nil {
+		return false, nil
+	}
+
+	if tree == node {
+		return true, nil
+	}
+
+	for _, child := range tree.Content {
+		if child == node {
+			return true, tree
+		}
+
+		if found, parent := findParentNode(child, node); found {
+			return true, parent
+		}
+	}
+
+	return false, nil
+}
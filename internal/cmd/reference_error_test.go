@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildOpenAPIModel_SpecBaseDir(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasInputPath string
+		specBaseDir  string
+		expectedErr  string // expected to be contained in the error message
+	}{
+		"default base dir": {
+			oasInputPath: "testdata/multifile/openapi_spec.yml",
+		},
+		"explicit base dir": {
+			oasInputPath: "testdata/multifile/openapi_spec.yml",
+			specBaseDir:  "testdata/multifile/",
+		},
+		"wrong base dir": {
+			oasInputPath: "testdata/multifile/openapi_spec.yml",
+			specBaseDir:  "testdata/multifile/paths",
+			expectedErr:  "openapi_spec.yml:13:13: unable to resolve reference 'schemas/common.yml#/WidgetId': component `#/WidgetId` does not exist in the specification",
+		},
+		"missing file": {
+			oasInputPath: "testdata/multifile/invalid_openapi_spec.yml",
+			expectedErr:  "invalid_openapi_spec.yml:15:23: unable to resolve reference 'schemas/missing.yml#/Widget': component `#/Widget` does not exist in the specification",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			model, err := buildOpenAPIModel(logger, testCase.oasInputPath, testCase.specBaseDir, nil)
+			if testCase.expectedErr != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedErr)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Errorf("expected error to contain %q, got: %s", testCase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(model.Paths.PathItems.Len(), 2); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// specInput is an OpenAPI spec file passed to the generate command, along with the alias used by the `spec` property of
+// the generator config.
+type specInput struct {
+	alias string
+	path  string
+}
+
+// parseSpecInputs parses OpenAPI spec arguments, either `<alias>=<path>` or `<path>`. If no alias is given, the file
+// name without extension is used, i.e. `specs/users.yml` = `users`.
+func parseSpecInputs(args []string) ([]specInput, error) {
+	inputs := make([]specInput, 0, len(args))
+	aliases := map[string]string{}
+
+	for _, arg := range args {
+		input := specInput{path: arg}
+
+		if alias, path, ok := splitAlias(arg); ok {
+			input.alias = alias
+			input.path = path
+		} else {
+			input.alias = strings.TrimSuffix(filepath.Base(arg), filepath.Ext(arg))
+		}
+
+		if input.path == "" {
+			return nil, fmt.Errorf("invalid OpenAPI spec argument %q - must be '<path>' or '<alias>=<path>'", arg)
+		}
+
+		if existingPath, ok := aliases[input.alias]; ok {
+			return nil, fmt.Errorf("OpenAPI specs %q and %q have the same alias %q, use '<alias>=<path>' to set a unique alias", existingPath, input.path, input.alias)
+		}
+		aliases[input.alias] = input.path
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+// splitAlias splits an `<alias>=<path>` argument, returning false if the argument is only a path.
+func splitAlias(arg string) (string, string, bool) {
+	alias, path, ok := strings.Cut(arg, "=")
+	if !ok || alias == "" || strings.ContainsAny(alias, `/\`) {
+		return "", "", false
+	}
+
+	return alias, path, true
+}
+
+// specModel is an OpenAPI model built from a spec file passed to the generate command.
+type specModel struct {
+	alias string
+	model *high.Document
+}
+
+// componentDefinition is a component in an OpenAPI spec, with a hash of the definition used to compare it to components
+// with the same name in other OpenAPI specs.
+type componentDefinition struct {
+	alias string
+	hash  [32]byte
+
+	// hashed is false if the component has no low-level model to hash, i.e. a component converted from Swagger 2.0
+	hashed bool
+}
+
+// logComponentCollisions logs a warning for each component name that is defined differently in multiple OpenAPI specs. Each
+// OpenAPI spec is explored separately, but a collision usually means that a shared component, i.e. `Error`, is out of sync.
+func logComponentCollisions(logger *slog.Logger, specs []specModel) {
+	definitions := map[string][]componentDefinition{}
+
+	for _, spec := range specs {
+		components := spec.model.Components
+		if components == nil {
+			continue
+		}
+
+		collectComponents(definitions, spec.alias, "schemas", components.Schemas, func(v *base.SchemaProxy) ([32]byte, bool) {
+			if v == nil || v.GoLow() == nil {
+				return [32]byte{}, false
+			}
+			return v.GoLow().Hash(), true
+		})
+		collectComponents(definitions, spec.alias, "parameters", components.Parameters, func(v *high.Parameter) ([32]byte, bool) {
+			if v == nil || v.GoLow() == nil {
+				return [32]byte{}, false
+			}
+			return v.GoLow().Hash(), true
+		})
+		collectComponents(definitions, spec.alias, "requestBodies", components.RequestBodies, func(v *high.RequestBody) ([32]byte, bool) {
+			if v == nil || v.GoLow() == nil {
+				return [32]byte{}, false
+			}
+			return v.GoLow().Hash(), true
+		})
+		collectComponents(definitions, spec.alias, "responses", components.Responses, func(v *high.Response) ([32]byte, bool) {
+			if v == nil || v.GoLow() == nil {
+				return [32]byte{}, false
+			}
+			return v.GoLow().Hash(), true
+		})
+		collectComponents(definitions, spec.alias, "securitySchemes", components.SecuritySchemes, func(v *high.SecurityScheme) ([32]byte, bool) {
+			if v == nil || v.GoLow() == nil {
+				return [32]byte{}, false
+			}
+			return v.GoLow().Hash(), true
+		})
+	}
+
+	refs := make([]string, 0, len(definitions))
+	for ref := range definitions {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	for _, ref := range refs {
+		componentDefs := definitions[ref]
+		if len(componentDefs) < 2 || identicalDefinitions(componentDefs) {
+			continue
+		}
+
+		aliases := make([]string, 0, len(componentDefs))
+		for _, def := range componentDefs {
+			aliases = append(aliases, def.alias)
+		}
+
+		logger.Warn(
+			"component name collision, defined differently in multiple OpenAPI specs",
+			"component", ref,
+			"specs", strings.Join(aliases, ", "))
+	}
+}
+
+func collectComponents[T any](definitions map[string][]componentDefinition, alias string, componentType string, components *orderedmap.Map[string, T], hash func(T) ([32]byte, bool)) {
+	for pair := range orderedmap.Iterate(context.TODO(), components) {
+		ref := fmt.Sprintf("#/components/%s/%s", componentType, pair.Key())
+
+		def := componentDefinition{alias: alias}
+		def.hash, def.hashed = hash(pair.Value())
+
+		definitions[ref] = append(definitions[ref], def)
+	}
+}
+
+func identicalDefinitions(defs []componentDefinition) bool {
+	for _, def := range defs {
+		if !def.hashed || def.hash != defs[0].hash {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+type ValidateCommand struct {
+	UI              cli.Ui
+	oasInputPaths   []string
+	flagConfigPath  string
+	flagOverlays    stringSliceFlag
+	flagSpecBaseDir string
+	flagOASOverlays stringSliceFlag
+}
+
+func (cmd *ValidateCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
+	fs.Var(&cmd.flagOverlays, "config-overlay", "path to a generator config overlay file (YAML) that patches the generator config, can be passed multiple times")
+	fs.Var(&cmd.flagOASOverlays, "overlay", "path to an OpenAPI Overlay document applied to the OpenAPI spec before validating, can be passed multiple times. Use '<alias>=<path>' to apply it to a specific OpenAPI spec")
+	fs.StringVar(&cmd.flagSpecBaseDir, "spec-base-dir", "", "base directory for resolving relative references to local files in the OpenAPI spec, defaults to the directory of the OpenAPI spec file")
+	return fs
+}
+
+func (cmd *ValidateCommand) Help() string {
+	return flagsHelp("tfplugingen-openapi validate [<args>] [<alias>=]</path/to/oas_file.yml> [[<alias>=]</path/to/another_oas_file.yml> ...]", cmd.Flags())
+}
+
+func (cmd *ValidateCommand) Synopsis() string {
+	return "Validates a generator config against an OpenAPI 3.x or Swagger 2.0 Specification, without generating output"
+}
+
+func (cmd *ValidateCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPaths = fs.Args()
+	if len(cmd.oasInputPaths) == 0 {
+		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	problems, err := cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	if len(problems) > 0 {
+		cmd.UI.Error(fmt.Sprintf("generator config %q is invalid, found %d problem(s):", cmd.flagConfigPath, len(problems)))
+		for _, problem := range problems {
+			cmd.UI.Error(fmt.Sprintf("  - %s", strings.ReplaceAll(problem.Error(), "\n", "\n    ")))
+		}
+		return 1
+	}
+
+	cmd.UI.Output(fmt.Sprintf("generator config %q is valid", cmd.flagConfigPath))
+	return 0
+}
+
+// runInternal returns all problems found in the generator config. An error is only returned if the generator config or
+// OpenAPI spec can't be read or parsed, in which case no further validation is possible.
+func (cmd *ValidateCommand) runInternal(logger *slog.Logger) ([]error, error) {
+	// 1. Read and parse generator config file
+	configBytes, err := config.ReadConfig(cmd.flagConfigPath, cmd.flagOverlays)
+	if err != nil {
+		return nil, fmt.Errorf("error reading generator config file: %w", err)
+	}
+	cfg, err := config.ParseConfig(configBytes)
+	if err != nil {
+		return sortedProblems(err), nil
+	}
+
+	// Unused ignores and overrides are always reported as problems
+	cfg.Options.Strict = true
+
+	// 2. Read and parse OpenAPI spec files, the first OpenAPI spec is the default for generator config entries without a `spec`
+	specInputs, err := parseSpecInputs(cmd.oasInputPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make([]string, 0, len(specInputs))
+	for _, specInput := range specInputs {
+		aliases = append(aliases, specInput.alias)
+	}
+	if err := cfg.ValidateSpecAliases(aliases); err != nil {
+		return sortedProblems(err), nil
+	}
+
+	overlayPaths, err := parseOverlayInputs(cmd.flagOASOverlays, specInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Validate the generator config against the OpenAPI model of each OpenAPI spec
+	var problems []error
+	for i, specInput := range specInputs {
+		model, err := buildOpenAPIModel(logger, specInput.path, cmd.flagSpecBaseDir, overlayPaths[specInput.alias])
+		if err != nil {
+			return nil, fmt.Errorf("OpenAPI spec %q: %w", specInput.alias, err)
+		}
+
+		specConfig := cfg.ForSpec(specInput.alias, i == 0)
+		specProblems := validateConfig(logger, explorer.NewConfigExplorer(*model, specConfig), specConfig)
+
+		// Problems are prefixed with the OpenAPI spec alias when validating multiple OpenAPI specs
+		for _, problem := range specProblems {
+			if len(specInputs) > 1 {
+				problem = fmt.Errorf("OpenAPI spec %q: %w", specInput.alias, problem)
+			}
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems, nil
+}
+
+func validateConfig(logger *slog.Logger, dora explorer.Explorer, cfg config.Config) []error {
+	var problems []error
+
+	// 1. Resolve the path and method of every resource operation, then map each resource schema
+	explorerResources, err := dora.FindResources()
+	problems = append(problems, sortedProblems(err)...)
+
+	for _, name := range util.SortedKeys(explorerResources) {
+		explorerResource := explorerResources[name]
+		resourceConfig := cfg.Resources[name]
+
+		problems = append(problems, checkOperations(fmt.Sprintf("resource '%s'", name), map[string]*operationLocation{
+			"create": {resourceConfig.Create, explorerResource.CreateOp},
+			"read":   {resourceConfig.Read, explorerResource.ReadOp},
+			"update": {resourceConfig.Update, explorerResource.UpdateOp},
+			"delete": {resourceConfig.Delete, explorerResource.DeleteOp},
+		})...)
+
+		resourceMapper := mapper.NewResourceMapper(map[string]explorer.Resource{name: explorerResource}, cfg)
+		resources, err := resourceMapper.MapToIR(logger)
+		if err != nil {
+			problems = append(problems, err)
+		} else if len(resources) == 0 {
+			problems = append(problems, fmt.Errorf("resource '%s': unable to map schema, see warnings for details", name))
+		}
+	}
+
+	// 2. Resolve the path and method of every data source operation, then map each data source schema
+	explorerDataSources, err := dora.FindDataSources()
+	problems = append(problems, sortedProblems(err)...)
+
+	for _, name := range util.SortedKeys(explorerDataSources) {
+		explorerDataSource := explorerDataSources[name]
+		dataSourceConfig := cfg.DataSources[name]
+
+		problems = append(problems, checkOperations(fmt.Sprintf("data source '%s'", name), map[string]*operationLocation{
+			"read": {dataSourceConfig.Read, explorerDataSource.ReadOp},
+		})...)
+
+		dataSourceMapper := mapper.NewDataSourceMapper(map[string]explorer.DataSource{name: explorerDataSource}, cfg)
+		dataSources, err := dataSourceMapper.MapToIR(logger)
+		if err != nil {
+			problems = append(problems, err)
+		} else if len(dataSources) == 0 {
+			problems = append(problems, fmt.Errorf("data source '%s': unable to map schema, see warnings for details", name))
+		}
+	}
+
+	// 3. Resolve the provider schema_ref, then map the provider schema
+	explorerProvider, err := dora.FindProvider()
+	if err != nil {
+		return append(problems, fmt.Errorf("provider '%s': %w", cfg.Provider.Name, err))
+	}
+
+	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg)
+	_, err = providerMapper.MapToIR(logger)
+	if err != nil {
+		problems = append(problems, fmt.Errorf("provider '%s': %w", cfg.Provider.Name, err))
+	}
+
+	return problems
+}
+
+// operationLocation is an operation location from the generator config and the matching operation found in the OpenAPI spec.
+type operationLocation struct {
+	config    *config.OpenApiSpecLocation
+	operation *high.Operation
+}
+
+// checkOperations returns a problem for each operation in the generator config where the path exists in the OpenAPI spec,
+// but no operation is defined for the method.
+func checkOperations(owner string, locations map[string]*operationLocation) []error {
+	var problems []error
+
+	for _, opName := range util.SortedKeys(locations) {
+		location := locations[opName]
+		if location.config == nil || location.operation != nil {
+			continue
+		}
+
+		problems = append(problems, fmt.Errorf(
+			"%s: '%s' operation not found, method '%s' is not defined at OpenAPI path '%s'",
+			owner,
+			opName,
+			location.config.Method,
+			location.config.Path,
+		))
+	}
+
+	return problems
+}
+
+// flattenErrors splits joined errors into a list of problems, sorted by message as the explorer finds operations in random order.
+func sortedProblems(err error) []error {
+	errs := util.FlattenErrors(err)
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errs
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		configPath       string
+		expectedExitCode int
+		expectedError    string
+	}{
+		"Swagger Petstore - OpenAPI 3.0": {
+			oasSpecPath: "testdata/petstore3/openapi_spec.json",
+			configPath:  "testdata/petstore3/generator_config.yml",
+		},
+		"Scaleway - Instance API": {
+			oasSpecPath: "testdata/scaleway/openapi_spec.yml",
+			configPath:  "testdata/scaleway/generator_config.yml",
+		},
+		"EdgeCase API": {
+			oasSpecPath: "testdata/edgecase/openapi_spec.yml",
+			configPath:  "testdata/edgecase/generator_config.yml",
+		},
+		"Kubernetes API": {
+			oasSpecPath: "testdata/kubernetes/openapi_spec.json",
+			configPath:  "testdata/kubernetes/generator_config.yml",
+		},
+		"Swagger Petstore - Schema Defaults": {
+			oasSpecPath: "testdata/petstore3/openapi_spec.json",
+			configPath:  "testdata/defaults/generator_config.yml",
+		},
+		"Swagger Petstore - Aliases": {
+			oasSpecPath: "testdata/petstore3/openapi_spec.json",
+			configPath:  "testdata/aliases/generator_config.yml",
+		},
+		"Swagger Petstore - Identity": {
+			oasSpecPath: "testdata/petstore3/openapi_spec.json",
+			configPath:  "testdata/identity/generator_config.yml",
+		},
+		"invalid config": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			configPath:       "testdata/validate/invalid_generator_config.yml",
+			expectedExitCode: 1,
+			expectedError: `generator config "testdata/validate/invalid_generator_config.yml" is invalid, found 5 problem(s):
+  - failed to extract 'order.create': path '/store/orders' not found in OpenAPI spec
+  - resource 'pet': 'update' operation not found, method 'PATCH' is not defined at OpenAPI path '/pet'
+  - resource 'pet': unused schema option: ignore 'does_not_exist' did not match any attribute
+    unused schema option: alias 'petIdentifier' did not match any parameter or attribute
+  - data source 'pet': unused schema option: override 'category.missing' - no matching attribute found for 'missing'
+  - provider 'petstore': error extracting provider schema from ref: unable to find reference: #/components/schemas/Missing
+`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{UI: mockUi}
+			args := []string{
+				"--config", testCase.configPath,
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), testCase.expectedError); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_WithMultipleSpecs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPaths     []string
+		configPath       string
+		expectedExitCode int
+		expectedError    string
+	}{
+		"default aliases": {
+			oasSpecPaths: []string{"testdata/multispec/users.yml", "testdata/multispec/billing.yml"},
+			configPath:   "testdata/multispec/generator_config.yml",
+		},
+		"explicit aliases": {
+			oasSpecPaths: []string{"users=testdata/multispec/users.yml", "billing=testdata/multispec/billing.yml"},
+			configPath:   "testdata/multispec/generator_config.yml",
+		},
+		"unknown alias": {
+			oasSpecPaths:     []string{"testdata/multispec/users.yml", "payments=testdata/multispec/billing.yml"},
+			configPath:       "testdata/multispec/generator_config.yml",
+			expectedExitCode: 1,
+			expectedError: `generator config "testdata/multispec/generator_config.yml" is invalid, found 3 problem(s):
+  - data_source 'invoices' has an unknown spec: "billing" - must be one of ["users" "payments"]
+  - provider has an unknown spec: "billing" - must be one of ["users" "payments"]
+  - resource 'invoice' has an unknown spec: "billing" - must be one of ["users" "payments"]
+`,
+		},
+		"wrong default spec": {
+			oasSpecPaths:     []string{"testdata/multispec/billing.yml", "testdata/multispec/users.yml"},
+			configPath:       "testdata/multispec/generator_config.yml",
+			expectedExitCode: 1,
+			expectedError: `generator config "testdata/multispec/generator_config.yml" is invalid, found 1 problem(s):
+  - OpenAPI spec "billing": failed to extract 'user.create': path '/users' not found in OpenAPI spec
+`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{UI: mockUi}
+			args := append([]string{
+				"--config", testCase.configPath,
+			}, testCase.oasSpecPaths...)
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), testCase.expectedError); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_WithOverlay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overlayPaths     []string
+		expectedExitCode int
+		expectedError    string
+	}{
+		"aliased spec": {
+			overlayPaths: []string{"billing=testdata/validate/billing_overlay.yml"},
+		},
+		"default spec": {
+			overlayPaths:     []string{"testdata/validate/billing_overlay.yml"},
+			expectedExitCode: 1,
+			expectedError: `generator config "testdata/validate/overlay_generator_config.yml" is invalid, found 1 problem(s):
+  - OpenAPI spec "billing": resource 'invoice': unused schema option: ignore 'currency' did not match any attribute
+`,
+		},
+		"unknown alias": {
+			overlayPaths:     []string{"payments=testdata/validate/billing_overlay.yml"},
+			expectedExitCode: 1,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{UI: mockUi}
+			args := []string{
+				"--config", "testdata/validate/overlay_generator_config.yml",
+			}
+			for _, overlayPath := range testCase.overlayPaths {
+				args = append(args, "--overlay", overlayPath)
+			}
+			args = append(args, "testdata/multispec/users.yml", "testdata/multispec/billing.yml")
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), testCase.expectedError); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// includeKey is the top-level generator config property containing file paths (or globs) of other generator config files
+// to include, relative to the file that includes them.
+const includeKey = "include"
+
+// mergedSections are the top-level generator config properties that are merged by name across included files. Any other
+// top-level property, i.e. `provider` or `options`, can only be defined in one file.
+var mergedSections = map[string]string{
+	"resources":    "resource",
+	"data_sources": "data_source",
+}
+
+// configFile is a parsed generator config file, along with the file each top-level property and each resource/data source
+// was defined in, which is used to report conflicts between included files.
+type configFile struct {
+	values  map[string]any
+	sources map[string]string
+}
+
+// ReadConfig reads a generator config file, resolving any `include` directives, and then applies each overlay file in order.
+// The result is a single merged YAML document, which can be passed to ParseConfig for validation.
+//
+// Included files are merged by resource and data source name, with the same resource or data source defined in multiple files
+// reported as a conflict. Overlays patch the merged generator config: maps are merged recursively, any other value replaces the
+// existing value, and a `null` value removes the existing value.
+func ReadConfig(path string, overlayPaths []string) ([]byte, error) {
+	base, err := readConfigFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, overlayPath := range overlayPaths {
+		overlay, err := readYAMLFile(overlayPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := overlay[includeKey]; ok {
+			return nil, fmt.Errorf("overlay %q: '%s' is not supported in overlay files", overlayPath, includeKey)
+		}
+
+		base.values = applyOverlay(base.values, overlay)
+	}
+
+	bytes, err := yaml.Marshal(base.values)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling merged config: %w", err)
+	}
+
+	return bytes, nil
+}
+
+// readConfigFile reads a generator config file and recursively merges all included files into it. The stack contains the
+// absolute paths of the files currently being included, to detect include cycles.
+func readConfigFile(path string, stack []string) (*configFile, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving config file path %q: %w", path, err)
+	}
+
+	for _, stackPath := range stack {
+		if stackPath == absPath {
+			return nil, fmt.Errorf("config file %q includes itself", path)
+		}
+	}
+	stack = append(stack, absPath)
+
+	values, err := readYAMLFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	includes, err := includePatterns(path, values[includeKey])
+	if err != nil {
+		return nil, err
+	}
+	delete(values, includeKey)
+
+	result := &configFile{
+		values:  map[string]any{},
+		sources: map[string]string{},
+	}
+
+	var errResult error
+	errResult = errors.Join(errResult, result.merge(path, &configFile{values: values}))
+
+	for _, pattern := range includes {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("config file %q has an invalid include %q: %w", path, pattern, err))
+			continue
+		}
+
+		if len(matches) == 0 {
+			errResult = errors.Join(errResult, fmt.Errorf("config file %q has an include %q that doesn't match any files", path, pattern))
+			continue
+		}
+
+		sort.Strings(matches)
+		for _, match := range matches {
+			included, err := readConfigFile(match, stack)
+			if err != nil {
+				errResult = errors.Join(errResult, err)
+				continue
+			}
+
+			errResult = errors.Join(errResult, result.merge(match, included))
+		}
+	}
+
+	if errResult != nil {
+		return nil, errResult
+	}
+
+	return result, nil
+}
+
+// merge adds the top-level properties, resources, and data sources of another config file, returning an error for anything
+// that has already been defined in a different file. A file that is included more than once, i.e. by two files that are both
+// included by the same file, is only merged once.
+func (c *configFile) merge(path string, other *configFile) error {
+	var result error
+
+	sourceOf := func(key string) string {
+		if source, ok := other.sources[key]; ok {
+			return source
+		}
+		return path
+	}
+
+	for _, key := range util.SortedKeys(other.values) {
+		value := other.values[key]
+
+		section, ok := mergedSections[key]
+		if !ok {
+			if existingSource, ok := c.sources[key]; ok {
+				if isSameFile(existingSource, sourceOf(key)) {
+					continue
+				}
+
+				result = errors.Join(result, fmt.Errorf("'%s' is defined in multiple config files: %q and %q", key, existingSource, sourceOf(key)))
+				continue
+			}
+
+			c.values[key] = value
+			c.sources[key] = sourceOf(key)
+			continue
+		}
+
+		if value == nil {
+			continue
+		}
+
+		items, ok := value.(map[string]any)
+		if !ok {
+			result = errors.Join(result, fmt.Errorf("config file %q: '%s' must be a map", sourceOf(key), key))
+			continue
+		}
+
+		existingItems, ok := c.values[key].(map[string]any)
+		if !ok {
+			existingItems = map[string]any{}
+			c.values[key] = existingItems
+		}
+
+		for _, name := range util.SortedKeys(items) {
+			itemKey := key + "." + name
+
+			if existingSource, ok := c.sources[itemKey]; ok {
+				if isSameFile(existingSource, sourceOf(itemKey)) {
+					continue
+				}
+
+				result = errors.Join(result, fmt.Errorf("%s '%s' is defined in multiple config files: %q and %q", section, name, existingSource, sourceOf(itemKey)))
+				continue
+			}
+
+			existingItems[name] = items[name]
+			c.sources[itemKey] = sourceOf(itemKey)
+		}
+	}
+
+	return result
+}
+
+// isSameFile checks if two config file paths, which can be relative to different directories, refer to the same file.
+func isSameFile(pathA, pathB string) bool {
+	absPathA, err := filepath.Abs(pathA)
+	if err != nil {
+		return false
+	}
+
+	absPathB, err := filepath.Abs(pathB)
+	if err != nil {
+		return false
+	}
+
+	return absPathA == absPathB
+}
+
+// includePatterns returns the include globs of a config file, relative to the directory of the config file.
+func includePatterns(path string, value any) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	rawPatterns, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("config file %q: '%s' must be a list of file paths", path, includeKey)
+	}
+
+	patterns := make([]string, 0, len(rawPatterns))
+	for _, rawPattern := range rawPatterns {
+		pattern, ok := rawPattern.(string)
+		if !ok || strings.TrimSpace(pattern) == "" {
+			return nil, fmt.Errorf("config file %q: '%s' must be a list of file paths, found %v", path, includeKey, rawPattern)
+		}
+
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(path), pattern)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+// applyOverlay recursively merges the overlay into the base, where a `null` value in the overlay removes the key from the base.
+func applyOverlay(base map[string]any, overlay map[string]any) map[string]any {
+	if base == nil {
+		base = map[string]any{}
+	}
+
+	for key, overlayValue := range overlay {
+		if overlayValue == nil {
+			delete(base, key)
+			continue
+		}
+
+		overlayMap, overlayIsMap := overlayValue.(map[string]any)
+		baseMap, baseIsMap := base[key].(map[string]any)
+		if overlayIsMap && baseIsMap {
+			base[key] = applyOverlay(baseMap, overlayMap)
+			continue
+		}
+
+		base[key] = overlayValue
+	}
+
+	return base
+}
+
+func readYAMLFile(path string) (map[string]any, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(bytes, &values); err != nil {
+		return nil, fmt.Errorf("error unmarshaling config file %q: %w", path, err)
+	}
+
+	return values, nil
+}
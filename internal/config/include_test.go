@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+)
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		files          map[string]string
+		overlays       []string
+		want           *config.Config
+		expectedErrMsg string
+	}{
+		"include glob": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example
+include:
+  - resources/*.yml`,
+				"resources/a.yml": `
+resources:
+  thing_a:
+    create:
+      path: /a
+      method: POST
+    read:
+      path: /a
+      method: GET`,
+				"resources/b.yml": `
+resources:
+  thing_b:
+    create:
+      path: /b
+      method: POST
+    read:
+      path: /b
+      method: GET
+data_sources:
+  thing_b:
+    read:
+      path: /b
+      method: GET`,
+			},
+			want: &config.Config{
+				Provider: config.Provider{Name: "example"},
+				Resources: map[string]config.Resource{
+					"thing_a": {
+						Create: &config.OpenApiSpecLocation{Path: "/a", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/a", Method: "GET"},
+					},
+					"thing_b": {
+						Create: &config.OpenApiSpecLocation{Path: "/b", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/b", Method: "GET"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"thing_b": {Read: &config.OpenApiSpecLocation{Path: "/b", Method: "GET"}},
+				},
+			},
+		},
+		"nested include": {
+			files: map[string]string{
+				"generator_config.yml": `
+include:
+  - domains/provider.yml`,
+				"domains/provider.yml": `
+provider:
+  name: example
+include:
+  - things.yml`,
+				"domains/things.yml": `
+data_sources:
+  thing:
+    read:
+      path: /thing
+      method: GET`,
+			},
+			want: &config.Config{
+				Provider: config.Provider{Name: "example"},
+				DataSources: map[string]config.DataSource{
+					"thing": {Read: &config.OpenApiSpecLocation{Path: "/thing", Method: "GET"}},
+				},
+			},
+		},
+		"overlays": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example
+options:
+  merge_strategy: fail
+resources:
+  thing:
+    create:
+      path: /thing
+      method: POST
+    read:
+      path: /thing
+      method: GET
+    schema:
+      ignores:
+        - a
+        - b
+  other_thing:
+    create:
+      path: /other_thing
+      method: POST
+    read:
+      path: /other_thing
+      method: GET`,
+				"overlays/prod.yml": `
+provider:
+  name: example_prod
+resources:
+  thing:
+    schema:
+      ignores:
+        - c
+  other_thing: null`,
+				"overlays/lenient.yml": `
+options:
+  merge_strategy: warn`,
+			},
+			overlays: []string{"overlays/prod.yml", "overlays/lenient.yml"},
+			want: &config.Config{
+				Provider: config.Provider{Name: "example_prod"},
+				Options:  config.Options{MergeStrategy: config.MergeStrategyWarn},
+				Resources: map[string]config.Resource{
+					"thing": {
+						Create:        &config.OpenApiSpecLocation{Path: "/thing", Method: "POST"},
+						Read:          &config.OpenApiSpecLocation{Path: "/thing", Method: "GET"},
+						SchemaOptions: config.SchemaOptions{Ignores: []string{"c"}},
+					},
+				},
+			},
+		},
+		"resource conflict": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example
+resources:
+  thing:
+    read:
+      path: /thing
+      method: GET
+include:
+  - things.yml`,
+				"things.yml": `
+resources:
+  thing:
+    read:
+      path: /thing
+      method: GET`,
+			},
+			expectedErrMsg: `resource 'thing' is defined in multiple config files: "generator_config.yml" and "things.yml"`,
+		},
+		"provider conflict": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example
+include:
+  - provider.yml`,
+				"provider.yml": `
+provider:
+  name: example`,
+			},
+			expectedErrMsg: `'provider' is defined in multiple config files: "generator_config.yml" and "provider.yml"`,
+		},
+		"include cycle": {
+			files: map[string]string{
+				"generator_config.yml": `
+include:
+  - a.yml`,
+				"a.yml": `
+include:
+  - generator_config.yml`,
+			},
+			expectedErrMsg: `config file "generator_config.yml" includes itself`,
+		},
+		"diamond include": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example
+include:
+  - a/a.yml
+  - b/b.yml`,
+				"a/a.yml": `
+include:
+  - ../shared/shared.yml
+resources:
+  thing_a:
+    create:
+      path: /a
+      method: POST
+    read:
+      path: /a
+      method: GET`,
+				"b/b.yml": `
+include:
+  - ../shared/shared.yml`,
+				"shared/shared.yml": `
+options:
+  strict: true
+data_sources:
+  thing_shared:
+    read:
+      path: /shared
+      method: GET`,
+			},
+			want: &config.Config{
+				Provider: config.Provider{
+					Name: "example",
+				},
+				Options: config.Options{
+					Strict: true,
+				},
+				Resources: map[string]config.Resource{
+					"thing_a": {
+						Create: &config.OpenApiSpecLocation{Path: "/a", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/a", Method: "GET"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"thing_shared": {
+						Read: &config.OpenApiSpecLocation{Path: "/shared", Method: "GET"},
+					},
+				},
+			},
+		},
+		"include without matches": {
+			files: map[string]string{
+				"generator_config.yml": `
+include:
+  - resources/*.yml`,
+			},
+			expectedErrMsg: `config file "generator_config.yml" has an include "resources/*.yml" that doesn't match any files`,
+		},
+		"include in overlay": {
+			files: map[string]string{
+				"generator_config.yml": `
+provider:
+  name: example`,
+				"overlay.yml": `
+include:
+  - other.yml`,
+			},
+			overlays:       []string{"overlay.yml"},
+			expectedErrMsg: `overlay "overlay.yml": 'include' is not supported in overlay files`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for path, contents := range testCase.files {
+				fullPath := filepath.Join(dir, path)
+				if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(fullPath, []byte(contents), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			overlayPaths := make([]string, 0, len(testCase.overlays))
+			for _, overlay := range testCase.overlays {
+				overlayPaths = append(overlayPaths, filepath.Join(dir, overlay))
+			}
+
+			bytes, err := config.ReadConfig(filepath.Join(dir, "generator_config.yml"), overlayPaths)
+
+			if testCase.expectedErrMsg != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got nil", testCase.expectedErrMsg)
+				}
+
+				// File paths are relative to the temporary directory
+				expectedErrMsg := testCase.expectedErrMsg
+				for path := range testCase.files {
+					expectedErrMsg = replaceQuoted(expectedErrMsg, path, filepath.Join(dir, path))
+				}
+				expectedErrMsg = replaceQuoted(expectedErrMsg, "resources/*.yml", filepath.Join(dir, "resources/*.yml"))
+
+				if diff := cmp.Diff(err.Error(), expectedErrMsg); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := config.ParseConfig(bytes)
+			if err != nil {
+				t.Fatalf("unexpected error parsing merged config: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func replaceQuoted(s, oldValue, newValue string) string {
+	return strings.ReplaceAll(s, `"`+oldValue+`"`, `"`+newValue+`"`)
+}
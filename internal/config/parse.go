@@ -7,25 +7,68 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
 )
 
-// This regex matches attribute locations, dot-separated, as represented as {attribute_name}.{nested_attribute_name}
+// This regex matches attribute locations, dot-separated, as represented as {attribute_name}.{nested_attribute_name}. Each
+// attribute name can contain wildcards: `*` and `?`, a `[...]` character class, or `**` to match zero or more nested attributes.
 //   - category = MATCH
 //   - category.id = MATCH
 //   - category.tags.name = MATCH
+//   - *.metadata.etag = MATCH
+//   - **.links = MATCH
+//   - tags.* = MATCH
 //   - category. = NO MATCH
 //   - .category = NO MATCH
-var attributeLocationRegex = regexp.MustCompile(`^[\w]+(?:\.[\w]+)*$`)
+var attributeLocationRegex = regexp.MustCompile(`^(?:[\w*?]|\[[^\].]+\])+(?:\.(?:[\w*?]|\[[^\].]+\])+)*$`)
+
+// This regex matches an identity or import attribute name, which must be a top-level attribute.
+var identityAttributeRegex = regexp.MustCompile(`^\w+$`)
 
 // Config represents a YAML generator config.
 type Config struct {
 	Provider    Provider              `yaml:"provider"`
+	Options     Options               `yaml:"options"`
+	Defaults    Defaults              `yaml:"defaults"`
 	Resources   map[string]Resource   `yaml:"resources"`
 	DataSources map[string]DataSource `yaml:"data_sources"`
 }
 
+// Defaults generator config section. This section contains defaults that are inherited by all resources and data sources.
+type Defaults struct {
+	// SchemaOptions are merged with the schema options of every resource and data source, with the resource or data source schema options
+	// taking precedence. An inherited ignore can be removed from a resource or data source with a `!` prefix, i.e. `!etag`.
+	SchemaOptions SchemaOptions `yaml:"schema"`
+}
+
+// Options generator config section. This section contains options that apply to the mapping of the provider, all resources, and all data sources.
+type Options struct {
+	// DefaultIntegerFormat is the format used when mapping an OpenAPI integer that has no `format` defined, either "int64" (default) or "int32".
+	DefaultIntegerFormat string `yaml:"default_integer_format"`
+	// MergeStrategy determines how attributes with conflicting types are handled when merging request, response, and parameter attributes.
+	// Must be one of "warn" (default), "fail", or "prefer-response".
+	MergeStrategy string `yaml:"merge_strategy"`
+	// Strict turns the warnings for any ignores or overrides that don't match an attribute into errors. This can also be enabled with the
+	// `--strict` flag of the generate command.
+	Strict bool `yaml:"strict"`
+	// UseStateForUnknown adds a UseStateForUnknown plan modifier to all computed-only resource attributes, i.e. "id" or "created_at".
+	UseStateForUnknown bool `yaml:"use_state_for_unknown"`
+}
+
+const (
+	// MergeStrategyWarn logs a warning for each merge conflict and keeps the first attribute found (default).
+	MergeStrategyWarn = "warn"
+	// MergeStrategyFail returns an error for any merge conflict.
+	MergeStrategyFail = "fail"
+	// MergeStrategyPreferResponse logs a warning for each merge conflict and uses the response body attribute type.
+	MergeStrategyPreferResponse = "prefer-response"
+)
+
 // Provider generator config section.
 type Provider struct {
 	Name      string `yaml:"name"`
@@ -34,6 +77,9 @@ type Provider struct {
 	// TODO: At some point, this should probably be refactored to work with the SchemaOptions struct
 	// Ignores are a slice of strings, representing an attribute location to ignore during mapping (dot-separated for nested attributes).
 	Ignores []string `yaml:"ignores"`
+
+	// Spec is the alias of the OpenAPI spec that contains the `schema_ref`, when generating from multiple OpenAPI specs. Defaults to the first OpenAPI spec.
+	Spec string `yaml:"spec"`
 }
 
 // Resource generator config section.
@@ -43,12 +89,47 @@ type Resource struct {
 	Update        *OpenApiSpecLocation `yaml:"update"`
 	Delete        *OpenApiSpecLocation `yaml:"delete"`
 	SchemaOptions SchemaOptions        `yaml:"schema"`
+
+	// SkipUpdateRequest disables merging the update operation request body into the resource schema.
+	SkipUpdateRequest bool `yaml:"skip_update_request"`
+
+	// Identity declares the attribute that identifies the resource, which is mapped from a path parameter of the read operation.
+	Identity *Identity `yaml:"identity"`
+
+	// Import changes the import ID format, which is derived from the path parameters of the read operation.
+	Import *Import `yaml:"import"`
+
+	// Spec is the alias of the OpenAPI spec that contains the operations, when generating from multiple OpenAPI specs. Defaults to the first OpenAPI spec.
+	Spec string `yaml:"spec"`
 }
 
 // DataSource generator config section.
 type DataSource struct {
 	Read          *OpenApiSpecLocation `yaml:"read"`
 	SchemaOptions SchemaOptions        `yaml:"schema"`
+
+	// Spec is the alias of the OpenAPI spec that contains the read operation, when generating from multiple OpenAPI specs. Defaults to the first OpenAPI spec.
+	Spec string `yaml:"spec"`
+}
+
+// Identity generator config section. The path parameter of the read operation that identifies the resource, and any request or
+// response body property with the same name as the identity attribute, are mapped to a single computed and optional attribute.
+type Identity struct {
+	// Attribute is the name of the identity attribute, i.e. `id`.
+	Attribute string `yaml:"attribute"`
+	// Parameter is the path parameter of the read operation that identifies the resource, i.e. `widgetId` in `/widgets/{widgetId}`.
+	// Defaults to the last path parameter of the read operation path.
+	Parameter string `yaml:"parameter"`
+}
+
+// Import generator config section. By default, the import ID of a resource is the attributes mapped from each path parameter of
+// the read operation, in path order, separated by a `/`, i.e. `org_id/id` for `/orgs/{org_id}/users/{id}`.
+type Import struct {
+	// Separator is the string between each attribute in the import ID. Defaults to `/`.
+	Separator string `yaml:"separator"`
+	// Attributes are the names of the top-level attributes in the import ID, in order. Defaults to the attributes mapped from
+	// the read operation path parameters.
+	Attributes []string `yaml:"attributes"`
 }
 
 // OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation.
@@ -63,6 +144,9 @@ type OpenApiSpecLocation struct {
 	Method string `yaml:"method"`
 }
 
+// IgnoreOptOutPrefix is the prefix for an ignore in a resource or data source that removes an ignore inherited from the defaults, i.e. `!etag`.
+const IgnoreOptOutPrefix = "!"
+
 // SchemaOptions generator config section. This section contains options for modifying the output of the generator.
 type SchemaOptions struct {
 	// Ignores are a slice of strings, representing an attribute location to ignore during mapping (dot-separated for nested attributes).
@@ -72,7 +156,8 @@ type SchemaOptions struct {
 
 // AttributeOptions generator config section. This section is used to modify the output of specific attributes.
 type AttributeOptions struct {
-	// Aliases are a map, with the key being a parameter name in an OpenAPI operation and the value being the new name (alias).
+	// Aliases are a map, with the key being a parameter name in an OpenAPI operation or a dot-separated property location in an
+	// OpenAPI schema, and the value being the new name (alias).
 	Aliases map[string]string `yaml:"aliases"`
 	// Overrides are a map, with the key being an attribute location (dot-separated for nested attributes) and the value being overrides to apply to the attribute.
 	Overrides map[string]Override `yaml:"overrides"`
@@ -82,6 +167,14 @@ type AttributeOptions struct {
 type Override struct {
 	// Description overrides the description that was mapped/merged from the OpenAPI specification.
 	Description string `yaml:"description"`
+	// ComputedOptionalRequired overrides the computability of the attribute, one of "required", "optional", "computed", or "computed_optional".
+	ComputedOptionalRequired string `yaml:"computed_optional_required"`
+	// Sensitive overrides whether the attribute is sensitive.
+	Sensitive *bool `yaml:"sensitive"`
+	// Type forces the attribute to a different type, one of "string" (i.e. an object as a string containing JSON), "list", or "set".
+	Type string `yaml:"type"`
+	// DeprecationMessage sets a deprecation message for the attribute.
+	DeprecationMessage string `yaml:"deprecation_message"`
 }
 
 // ParseConfig takes in a byte array (of YAML), unmarshals into a Config struct, and validates the result
@@ -112,9 +205,21 @@ func (c Config) Validate() error {
 		result = errors.Join(result, fmt.Errorf("\tprovider %w", err))
 	}
 
+	// Validate Options
+	err = c.Options.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\toptions %w", err))
+	}
+
+	// Validate Defaults
+	err = c.Defaults.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tdefaults %w", err))
+	}
+
 	// Validate all Resources
 	for name, resource := range c.Resources {
-		err := resource.Validate()
+		err := errors.Join(resource.Validate(), c.Defaults.validateIgnoreOptOuts(resource.SchemaOptions))
 		if err != nil {
 			result = errors.Join(result, fmt.Errorf("\tresource '%s' %w", name, err))
 		}
@@ -122,7 +227,7 @@ func (c Config) Validate() error {
 
 	// Validate all Data Sources
 	for name, dataSource := range c.DataSources {
-		err := dataSource.Validate()
+		err := errors.Join(dataSource.Validate(), c.Defaults.validateIgnoreOptOuts(dataSource.SchemaOptions))
 		if err != nil {
 			result = errors.Join(result, fmt.Errorf("\tdata_source '%s' %w", name, err))
 		}
@@ -147,6 +252,59 @@ func (p Provider) Validate() error {
 	return result
 }
 
+func (o Options) Validate() error {
+	var result error
+
+	switch o.DefaultIntegerFormat {
+	case "", "int32", "int64":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid default_integer_format: %q - must be 'int32' or 'int64'", o.DefaultIntegerFormat))
+	}
+
+	switch o.MergeStrategy {
+	case "", MergeStrategyWarn, MergeStrategyFail, MergeStrategyPreferResponse:
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid merge_strategy: %q - must be 'warn', 'fail', or 'prefer-response'", o.MergeStrategy))
+	}
+
+	return result
+}
+
+func (d Defaults) Validate() error {
+	var result error
+
+	err := d.SchemaOptions.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid schema: %w", err))
+	}
+
+	for _, ignore := range d.SchemaOptions.Ignores {
+		if strings.HasPrefix(ignore, IgnoreOptOutPrefix) {
+			result = errors.Join(result, fmt.Errorf("invalid item for ignores: %q - '%s' can only be used to opt out of a default ignore in a resource or data source", ignore, IgnoreOptOutPrefix))
+		}
+	}
+
+	return result
+}
+
+// validateIgnoreOptOuts returns an error for each ignore opt-out in the schema options that doesn't match a default ignore.
+func (d Defaults) validateIgnoreOptOuts(schemaOpts SchemaOptions) error {
+	var result error
+
+	for _, ignore := range schemaOpts.Ignores {
+		optOut, ok := strings.CutPrefix(ignore, IgnoreOptOutPrefix)
+		if ok && !slices.Contains(d.SchemaOptions.Ignores, optOut) {
+			result = errors.Join(result, fmt.Errorf("invalid item for ignores: %q - %q is not a default ignore", ignore, optOut))
+		}
+	}
+
+	if result != nil {
+		return fmt.Errorf("invalid schema: %w", result)
+	}
+
+	return nil
+}
+
 func (r Resource) Validate() error {
 	var result error
 
@@ -182,6 +340,18 @@ func (r Resource) Validate() error {
 		result = errors.Join(result, fmt.Errorf("invalid schema: %w", err))
 	}
 
+	if r.Identity != nil && r.Read != nil {
+		err = r.Identity.Validate(r.Read.Path)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid identity: %w", err))
+		}
+	}
+
+	err = r.Import.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid import: %w", err))
+	}
+
 	return result
 }
 
@@ -205,6 +375,47 @@ func (d DataSource) Validate() error {
 	return result
 }
 
+func (i *Identity) Validate(readPath string) error {
+	var result error
+
+	if i.Attribute == "" {
+		result = errors.Join(result, errors.New("'attribute' property is required"))
+	} else if !identityAttributeRegex.MatchString(i.Attribute) {
+		result = errors.Join(result, fmt.Errorf("invalid attribute: %q - must be an attribute name, not a nested attribute location", i.Attribute))
+	}
+
+	readPathParameters := util.PathParameters(readPath)
+	if i.Parameter != "" && !slices.Contains(readPathParameters, i.Parameter) {
+		result = errors.Join(result, fmt.Errorf("parameter %q is not a path parameter of the read path %q", i.Parameter, readPath))
+	}
+
+	if i.Parameter == "" && len(readPathParameters) == 0 {
+		result = errors.Join(result, fmt.Errorf("read path %q has no path parameters", readPath))
+	}
+
+	return result
+}
+
+func (i *Import) Validate() error {
+	var result error
+	if i == nil {
+		return nil
+	}
+
+	for index, attribute := range i.Attributes {
+		if !identityAttributeRegex.MatchString(attribute) {
+			result = errors.Join(result, fmt.Errorf("invalid item for attributes: %q - must be an attribute name, not a nested attribute location", attribute))
+			continue
+		}
+
+		if slices.Contains(i.Attributes[:index], attribute) {
+			result = errors.Join(result, fmt.Errorf("invalid item for attributes: %q - attribute is duplicated", attribute))
+		}
+	}
+
+	return result
+}
+
 func (o *OpenApiSpecLocation) Validate() error {
 	var result error
 	if o == nil {
@@ -231,7 +442,7 @@ func (s *SchemaOptions) Validate() error {
 	}
 
 	for _, ignore := range s.Ignores {
-		if !attributeLocationRegex.MatchString(ignore) {
+		if !attributeLocationRegex.MatchString(strings.TrimPrefix(ignore, IgnoreOptOutPrefix)) {
 			result = errors.Join(result, fmt.Errorf("invalid item for ignores: %q - must be dot-separated string", ignore))
 		}
 	}
@@ -242,10 +453,43 @@ func (s *SchemaOptions) Validate() error {
 func (s *AttributeOptions) Validate() error {
 	var result error
 
-	for path := range s.Overrides {
+	for path, alias := range s.Aliases {
+		if !attributeLocationRegex.MatchString(path) {
+			result = errors.Join(result, fmt.Errorf("invalid key for alias: %q - must be dot-separated string", path))
+		}
+
+		if strings.TrimSpace(alias) == "" {
+			result = errors.Join(result, fmt.Errorf("invalid alias for %q: must not be empty", path))
+		}
+	}
+
+	for path, override := range s.Overrides {
 		if !attributeLocationRegex.MatchString(path) {
 			result = errors.Join(result, fmt.Errorf("invalid key for override: %q - must be dot-separated string", path))
 		}
+
+		err := override.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid override for %q: %w", path, err))
+		}
+	}
+
+	return result
+}
+
+func (o *Override) Validate() error {
+	var result error
+
+	switch o.ComputedOptionalRequired {
+	case "", "required", "optional", "computed", "computed_optional":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid computed_optional_required: %q - must be 'required', 'optional', 'computed', or 'computed_optional'", o.ComputedOptionalRequired))
+	}
+
+	switch o.Type {
+	case "", "string", "list", "set":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid type: %q - must be 'string', 'list', or 'set'", o.Type))
 	}
 
 	return result
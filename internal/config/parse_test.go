@@ -21,6 +21,25 @@ func TestParseConfig_Valid(t *testing.T) {
 provider:
   name: example
 
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+		},
+		"valid options with default integer format": {
+			input: `
+provider:
+  name: example
+
+options:
+  default_integer_format: int32
+  merge_strategy: prefer-response
+  use_state_for_unknown: true
+
 resources:
   thing:
     create:
@@ -47,6 +66,61 @@ resources:
       attributes:
         aliases:
           otherId: id`,
+		},
+		"valid resource with wildcard ignores and overrides": {
+			input: `
+provider:
+  name: example
+  ignores:
+    - "**.links"
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "*.metadata.etag"
+        - "tags.*"
+        - "status.condition[s]"
+      attributes:
+        overrides:
+          "**.created_at":
+            description: The creation time`,
+		},
+		"valid defaults": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  schema:
+    ignores:
+      - _links
+      - etag
+      - "**.self"
+    attributes:
+      aliases:
+        orgId: organization
+      overrides:
+        "**.created_at":
+          description: The creation time
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "!etag"`,
 		},
 		"valid resource with overrides": {
 			input: `
@@ -69,7 +143,12 @@ resources:
           "hey.there":
             description: Here is a test description for the 'there' property in 'hey'
           "hey.there.nested.thing":
-            description: Deeply nested property 'thing'`,
+            description: Deeply nested property 'thing'
+          "hey.there.nested.other":
+            computed_optional_required: computed_optional
+            sensitive: true
+            type: string
+            deprecation_message: Use 'thing' instead`,
 		},
 		"valid resource with ignores": {
 			input: `
@@ -227,6 +306,90 @@ data_sources:
       method: GET`,
 			expectedErrRegex: `invalid item for ignores: \".invalid.ignore.\"`,
 		},
+		"options - invalid default integer format": {
+			input: `
+provider:
+  name: example
+
+options:
+  default_integer_format: int16
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `options invalid default_integer_format: \"int16\" - must be 'int32' or 'int64'`,
+		},
+		"invalid merge strategy": {
+			input: `
+provider:
+  name: example
+
+options:
+  merge_strategy: prefer-request
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `options invalid merge_strategy: \"prefer-request\" - must be 'warn', 'fail', or 'prefer-response'`,
+		},
+		"defaults - invalid ignore": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  schema:
+    ignores:
+      - .etag
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `defaults invalid schema: invalid item for ignores: \".etag\" - must be dot-separated string`,
+		},
+		"defaults - ignore opt-out": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  schema:
+    ignores:
+      - "!etag"
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `defaults invalid item for ignores: \"!etag\" - '!' can only be used to opt out of a default ignore in a resource or data source`,
+		},
+		"data source - ignore opt-out without default": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  schema:
+    ignores:
+      - _links
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "!etag"`,
+			expectedErrRegex: `data_source 'thing_one' invalid schema: invalid item for ignores: \"!etag\" - \"etag\" is not a default ignore`,
+		},
 		"at least one resource or data source required": {
 			input: `
 provider:
@@ -345,6 +508,95 @@ resources:
       path: /example/path/to/things`,
 			expectedErrRegex: `invalid delete: 'method' property is required`,
 		},
+		"resource - identity without attribute": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    identity:
+      parameter: id`,
+			expectedErrRegex: `invalid identity: 'attribute' property is required`,
+		},
+		"resource - identity parameter not in read path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    identity:
+      attribute: id
+      parameter: thing_id`,
+			expectedErrRegex: `invalid identity: parameter \"thing_id\" is not a path parameter of the read path \"/example/path/to/thing/\{id\}\"`,
+		},
+		"resource - identity without read path parameters": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing
+      method: GET
+    identity:
+      attribute: id`,
+			expectedErrRegex: `invalid identity: read path \"/example/path/to/thing\" has no path parameters`,
+		},
+		"resource - nested identity attribute": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    identity:
+      attribute: metadata.id`,
+			expectedErrRegex: `invalid identity: invalid attribute: \"metadata.id\" - must be an attribute name, not a nested attribute location`,
+		},
+		"resource - invalid import attributes": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    import:
+      attributes:
+        - id
+        - metadata.name
+        - id`,
+			expectedErrRegex: `invalid import: invalid item for attributes: \"metadata.name\" - must be an attribute name, not a nested attribute location\ninvalid item for attributes: \"id\" - attribute is duplicated`,
+		},
 		"resource - invalid override key": {
 			input: `
 provider:
@@ -365,6 +617,46 @@ resources:
             description: Here is a test description for the 'hey' property`,
 			expectedErrRegex: `invalid key for override: \".hey\"`,
 		},
+		"resource - invalid override computability": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            computed_optional_required: optional_computed`,
+			expectedErrRegex: `invalid override for \"hey\": invalid computed_optional_required: \"optional_computed\"`,
+		},
+		"resource - invalid override type": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            type: object`,
+			expectedErrRegex: `invalid override for \"hey\": invalid type: \"object\" - must be 'string', 'list', or 'set'`,
+		},
 		"resource - invalid ignore item": {
 			input: `
 provider:
@@ -414,6 +706,38 @@ data_sources:
       path: /example/path/to/thing/{id}`,
 			expectedErrRegex: `invalid read: 'method' property is required`,
 		},
+		"data source - invalid alias key": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        aliases:
+          "spec..containerPort": port`,
+			expectedErrRegex: `invalid key for alias: \"spec..containerPort\"`,
+		},
+		"data source - empty alias": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        aliases:
+          spec.containerPort: ""`,
+			expectedErrRegex: `invalid alias for \"spec.containerPort\": must not be empty`,
+		},
 		"data source - invalid override key": {
 			input: `
 provider:
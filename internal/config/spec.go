@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// ForSpec returns a copy of the generator config that only contains the resources and data sources found in the OpenAPI spec
+// with the given alias. Resources and data sources without a `spec` belong to the default OpenAPI spec. The provider `schema_ref`
+// is only kept for the OpenAPI spec that contains it.
+func (c Config) ForSpec(alias string, isDefault bool) Config {
+	belongsToSpec := func(spec string) bool {
+		return spec == alias || (spec == "" && isDefault)
+	}
+
+	specConfig := Config{
+		Provider:    c.Provider,
+		Options:     c.Options,
+		Defaults:    c.Defaults,
+		Resources:   map[string]Resource{},
+		DataSources: map[string]DataSource{},
+	}
+
+	if !belongsToSpec(c.Provider.Spec) {
+		specConfig.Provider.SchemaRef = ""
+		specConfig.Provider.Ignores = nil
+	}
+
+	for name, resource := range c.Resources {
+		if belongsToSpec(resource.Spec) {
+			specConfig.Resources[name] = resource
+		}
+	}
+
+	for name, dataSource := range c.DataSources {
+		if belongsToSpec(dataSource.Spec) {
+			specConfig.DataSources[name] = dataSource
+		}
+	}
+
+	return specConfig
+}
+
+// ValidateSpecAliases returns an error for each `spec` in the generator config that doesn't match one of the OpenAPI spec aliases.
+func (c Config) ValidateSpecAliases(aliases []string) error {
+	var errs []error
+
+	checkAlias := func(section, spec string) {
+		if spec != "" && !slices.Contains(aliases, spec) {
+			errs = append(errs, fmt.Errorf("%s has an unknown spec: %q - must be one of %q", section, spec, aliases))
+		}
+	}
+
+	checkAlias("provider", c.Provider.Spec)
+
+	for _, name := range util.SortedKeys(c.Resources) {
+		checkAlias(fmt.Sprintf("resource '%s'", name), c.Resources[name].Spec)
+	}
+
+	for _, name := range util.SortedKeys(c.DataSources) {
+		checkAlias(fmt.Sprintf("data_source '%s'", name), c.DataSources[name].Spec)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+)
+
+func TestConfig_ForSpec(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		Provider: config.Provider{
+			Name:      "example",
+			Spec:      "billing",
+			SchemaRef: "#/components/schemas/BillingProvider",
+		},
+		Resources: map[string]config.Resource{
+			"user":    {},
+			"invoice": {Spec: "billing"},
+		},
+		DataSources: map[string]config.DataSource{
+			"users":    {},
+			"invoices": {Spec: "billing"},
+		},
+	}
+
+	testCases := map[string]struct {
+		alias     string
+		isDefault bool
+		want      config.Config
+	}{
+		"default spec": {
+			alias:     "users",
+			isDefault: true,
+			want: config.Config{
+				Provider: config.Provider{
+					Name: "example",
+					Spec: "billing",
+				},
+				Resources: map[string]config.Resource{
+					"user": {},
+				},
+				DataSources: map[string]config.DataSource{
+					"users": {},
+				},
+			},
+		},
+		"aliased spec": {
+			alias: "billing",
+			want: config.Config{
+				Provider: config.Provider{
+					Name:      "example",
+					Spec:      "billing",
+					SchemaRef: "#/components/schemas/BillingProvider",
+				},
+				Resources: map[string]config.Resource{
+					"invoice": {Spec: "billing"},
+				},
+				DataSources: map[string]config.DataSource{
+					"invoices": {Spec: "billing"},
+				},
+			},
+		},
+		"unknown spec": {
+			alias: "payments",
+			want: config.Config{
+				Provider: config.Provider{
+					Name: "example",
+					Spec: "billing",
+				},
+				Resources:   map[string]config.Resource{},
+				DataSources: map[string]config.DataSource{},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := cfg.ForSpec(testCase.alias, testCase.isDefault)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateSpecAliases(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cfg            config.Config
+		aliases        []string
+		expectedErrMsg string
+	}{
+		"no specs": {
+			cfg: config.Config{
+				Resources: map[string]config.Resource{"user": {}},
+			},
+			aliases: []string{"users"},
+		},
+		"known specs": {
+			cfg: config.Config{
+				Provider:    config.Provider{Spec: "billing"},
+				Resources:   map[string]config.Resource{"invoice": {Spec: "billing"}},
+				DataSources: map[string]config.DataSource{"users": {Spec: "users"}},
+			},
+			aliases: []string{"users", "billing"},
+		},
+		"unknown specs": {
+			cfg: config.Config{
+				Provider:    config.Provider{Spec: "billing"},
+				Resources:   map[string]config.Resource{"invoice": {Spec: "payments"}},
+				DataSources: map[string]config.DataSource{"users": {Spec: "accounts"}},
+			},
+			aliases: []string{"users", "billing"},
+			expectedErrMsg: "resource 'invoice' has an unknown spec: \"payments\" - must be one of [\"users\" \"billing\"]\n" +
+				"data_source 'users' has an unknown spec: \"accounts\" - must be one of [\"users\" \"billing\"]",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.cfg.ValidateSpecAliases(testCase.aliases)
+
+			if testCase.expectedErrMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", testCase.expectedErrMsg)
+			}
+
+			if diff := cmp.Diff(err.Error(), testCase.expectedErrMsg); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -93,7 +97,12 @@ func (e configExplorer) FindResources() (map[string]Resource, error) {
 			UpdateOp:         updateOp,
 			DeleteOp:         deleteOp,
 			CommonParameters: commonParameters,
-			SchemaOptions:    extractSchemaOptions(resourceConfig.SchemaOptions),
+			SchemaOptions:    extractSchemaOptions(e.config.Defaults.SchemaOptions, resourceConfig.SchemaOptions),
+
+			SkipUpdateRequest: resourceConfig.SkipUpdateRequest,
+			ReadPath:          resourceConfig.Read.Path,
+			Identity:          extractIdentity(resourceConfig.Identity),
+			Import:            extractImport(resourceConfig.Import),
 		}
 	}
 
@@ -120,12 +129,34 @@ func (e configExplorer) FindDataSources() (map[string]DataSource, error) {
 		dataSources[name] = DataSource{
 			ReadOp:           readOp,
 			CommonParameters: commonParameters,
-			SchemaOptions:    extractSchemaOptions(dataSourceConfig.SchemaOptions),
+			SchemaOptions:    extractSchemaOptions(e.config.Defaults.SchemaOptions, dataSourceConfig.SchemaOptions),
 		}
 	}
 	return dataSources, errResult
 }
 
+func extractIdentity(cfgIdentity *config.Identity) *Identity {
+	if cfgIdentity == nil {
+		return nil
+	}
+
+	return &Identity{
+		Attribute: cfgIdentity.Attribute,
+		Parameter: cfgIdentity.Parameter,
+	}
+}
+
+func extractImport(cfgImport *config.Import) *Import {
+	if cfgImport == nil {
+		return nil
+	}
+
+	return &Import{
+		Separator:  cfgImport.Separator,
+		Attributes: cfgImport.Attributes,
+	}
+}
+
 func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*high.Operation, error) {
 	// No need to search OAS if not defined
 	if oasLocation == nil {
@@ -198,20 +229,101 @@ func extractSchemaProxy(document high.Document, componentRef string) (*highbase.
 	return highbase.CreateSchemaProxy(highSchema), nil
 }
 
-func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions) SchemaOptions {
-	return SchemaOptions{
+// extractSchemaOptions combines the default schema options from the generator config with the schema options of a resource or data
+// source, which take precedence. Ignores with an opt-out prefix, i.e. `!etag`, remove the matching default ignore.
+func extractSchemaOptions(cfgDefaultOpts config.SchemaOptions, cfgSchemaOpts config.SchemaOptions) SchemaOptions {
+	schemaOpts := SchemaOptions{
 		Ignores: cfgSchemaOpts.Ignores,
 		AttributeOptions: AttributeOptions{
 			Aliases:   cfgSchemaOpts.AttributeOptions.Aliases,
 			Overrides: extractOverrides(cfgSchemaOpts.AttributeOptions.Overrides),
 		},
 	}
+
+	if len(cfgDefaultOpts.Ignores) == 0 && len(cfgDefaultOpts.AttributeOptions.Aliases) == 0 && len(cfgDefaultOpts.AttributeOptions.Overrides) == 0 {
+		return schemaOpts
+	}
+
+	// 1. Ignores - default ignores that haven't been opted out of, followed by the resource or data source ignores
+	ignores := make([]string, 0, len(cfgDefaultOpts.Ignores)+len(cfgSchemaOpts.Ignores))
+	for _, ignore := range cfgDefaultOpts.Ignores {
+		if slices.Contains(cfgSchemaOpts.Ignores, config.IgnoreOptOutPrefix+ignore) {
+			continue
+		}
+
+		ignores = append(ignores, ignore)
+		if !slices.Contains(cfgSchemaOpts.Ignores, ignore) {
+			schemaOpts.InheritedIgnores = append(schemaOpts.InheritedIgnores, ignore)
+		}
+	}
+	for _, ignore := range cfgSchemaOpts.Ignores {
+		if strings.HasPrefix(ignore, config.IgnoreOptOutPrefix) || slices.Contains(ignores, ignore) {
+			continue
+		}
+
+		ignores = append(ignores, ignore)
+	}
+	schemaOpts.Ignores = ignores
+
+	// 2. Aliases - resource or data source aliases replace default aliases for the same parameter
+	aliases := make(map[string]string, len(cfgDefaultOpts.AttributeOptions.Aliases)+len(cfgSchemaOpts.AttributeOptions.Aliases))
+	for _, paramName := range util.SortedKeys(cfgDefaultOpts.AttributeOptions.Aliases) {
+		if _, ok := cfgSchemaOpts.AttributeOptions.Aliases[paramName]; ok {
+			continue
+		}
+
+		aliases[paramName] = cfgDefaultOpts.AttributeOptions.Aliases[paramName]
+		schemaOpts.AttributeOptions.InheritedAliases = append(schemaOpts.AttributeOptions.InheritedAliases, paramName)
+	}
+	maps.Copy(aliases, cfgSchemaOpts.AttributeOptions.Aliases)
+	schemaOpts.AttributeOptions.Aliases = aliases
+
+	// 3. Overrides - resource or data source overrides are merged on top of default overrides for the same attribute location
+	overrides := extractOverrides(cfgDefaultOpts.AttributeOptions.Overrides)
+	for _, path := range util.SortedKeys(overrides) {
+		if _, ok := schemaOpts.AttributeOptions.Overrides[path]; !ok {
+			schemaOpts.AttributeOptions.InheritedOverrides = append(schemaOpts.AttributeOptions.InheritedOverrides, path)
+		}
+	}
+	for path, override := range schemaOpts.AttributeOptions.Overrides {
+		overrides[path] = mergeOverride(overrides[path], override)
+	}
+	schemaOpts.AttributeOptions.Overrides = overrides
+
+	return schemaOpts
+}
+
+// mergeOverride merges an override on top of a default override, where any field set in the override takes precedence.
+func mergeOverride(defaultOverride Override, override Override) Override {
+	if override.Description != "" {
+		defaultOverride.Description = override.Description
+	}
+	if override.ComputedOptionalRequired != "" {
+		defaultOverride.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+	if override.Sensitive != nil {
+		defaultOverride.Sensitive = override.Sensitive
+	}
+	if override.Type != "" {
+		defaultOverride.Type = override.Type
+	}
+	if override.DeprecationMessage != "" {
+		defaultOverride.DeprecationMessage = override.DeprecationMessage
+	}
+
+	return defaultOverride
 }
 
 func extractOverrides(cfgOverrides map[string]config.Override) map[string]Override {
 	overrides := make(map[string]Override, len(cfgOverrides))
 	for key, cfgOverride := range cfgOverrides {
-		overrides[key] = Override{Description: cfgOverride.Description}
+		overrides[key] = Override{
+			Description:              cfgOverride.Description,
+			ComputedOptionalRequired: schema.ComputedOptionalRequired(cfgOverride.ComputedOptionalRequired),
+			Sensitive:                cfgOverride.Sensitive,
+			Type:                     cfgOverride.Type,
+			DeprecationMessage:       cfgOverride.DeprecationMessage,
+		}
 	}
 
 	return overrides
@@ -85,6 +85,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadPath: "/resources/{resource_id}",
 					UpdateOp: &high.Operation{
 						Description: "update op here",
 						OperationId: "update_resource",
@@ -121,6 +122,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 							Path:   "/resources/one",
 							Method: "TRACE",
 						},
+						SkipUpdateRequest: true,
 					},
 				},
 			},
@@ -158,6 +160,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadPath: "/resources/two/{resource_id}",
 					UpdateOp: &high.Operation{
 						Description: "update op here",
 						OperationId: "update_resource",
@@ -171,6 +174,8 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 							Overrides: map[string]explorer.Override{},
 						},
 					},
+
+					SkipUpdateRequest: true,
 				},
 			},
 		},
@@ -303,6 +308,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 						Description: "read op here",
 						OperationId: "read_resource",
 					},
+					ReadPath: "/resources/{resource_id}",
 					SchemaOptions: explorer.SchemaOptions{
 						Ignores: []string{"ignore1.abc", "ignore2.def"},
 						AttributeOptions: explorer.AttributeOptions{
@@ -319,6 +325,167 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				},
 			},
 		},
+		"identity and import pass-through": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							Path:   "/resources",
+							Method: "POST",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:   "/resources/{resource_id}",
+							Method: "GET",
+						},
+						Identity: &config.Identity{
+							Attribute: "id",
+							Parameter: "resource_id",
+						},
+						Import: &config.Import{
+							Separator:  ":",
+							Attributes: []string{"id"},
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					ReadPath: "/resources/{resource_id}",
+					Identity: &explorer.Identity{
+						Attribute: "id",
+						Parameter: "resource_id",
+					},
+					Import: &explorer.Import{
+						Separator:  ":",
+						Attributes: []string{"id"},
+					},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"schema options with defaults": {
+			config: config.Config{
+				Defaults: config.Defaults{
+					SchemaOptions: config.SchemaOptions{
+						Ignores: []string{"_links", "etag", "self"},
+						AttributeOptions: config.AttributeOptions{
+							Aliases: map[string]string{
+								"otherId": "id",
+								"orgId":   "org",
+							},
+							Overrides: map[string]config.Override{
+								"**.created_at": {
+									Description:              "default description",
+									ComputedOptionalRequired: "computed",
+								},
+								"name": {
+									Description: "default name description",
+								},
+							},
+						},
+					},
+				},
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							Path:   "/resources",
+							Method: "POST",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:   "/resources/{resource_id}",
+							Method: "GET",
+						},
+						SchemaOptions: config.SchemaOptions{
+							Ignores: []string{"!etag", "self", "status"},
+							AttributeOptions: config.AttributeOptions{
+								Aliases: map[string]string{
+									"orgId": "organization",
+								},
+								Overrides: map[string]config.Override{
+									"**.created_at": {
+										Description: "test description for override",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					ReadPath: "/resources/{resource_id}",
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores:          []string{"_links", "self", "status"},
+						InheritedIgnores: []string{"_links"},
+						AttributeOptions: explorer.AttributeOptions{
+							Aliases: map[string]string{
+								"otherId": "id",
+								"orgId":   "organization",
+							},
+							InheritedAliases: []string{"otherId"},
+							Overrides: map[string]explorer.Override{
+								"**.created_at": {
+									Description:              "test description for override",
+									ComputedOptionalRequired: "computed",
+								},
+								"name": {
+									Description: "default name description",
+								},
+							},
+							InheritedOverrides: []string{"name"},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -4,6 +4,7 @@
 package explorer
 
 import (
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
@@ -23,6 +24,17 @@ type Resource struct {
 	DeleteOp         *high.Operation
 	CommonParameters []*high.Parameter
 	SchemaOptions    SchemaOptions
+
+	// SkipUpdateRequest disables merging the update operation request body into the resource schema.
+	SkipUpdateRequest bool
+
+	// ReadPath is the path template of the read operation, i.e. `/widgets/{widgetId}`.
+	ReadPath string
+	// Identity is the attribute that identifies the resource, if declared. Resources without an identity are mapped
+	// without merging any path parameters and properties that have different names.
+	Identity *Identity
+	// Import changes the separator or attributes of the import ID, if declared.
+	Import *Import
 }
 
 // DataSource contains a Read operation and schema options for configuration.
@@ -39,16 +51,43 @@ type Provider struct {
 	Ignores     []string
 }
 
+// Identity is the attribute that identifies a resource, along with the read operation path parameter it is mapped from.
+type Identity struct {
+	Attribute string
+	// Parameter is the read operation path parameter, if empty the last path parameter of the read path is used.
+	Parameter string
+}
+
+// Import changes the import ID format of a resource, which is derived from the read operation path parameters by default.
+type Import struct {
+	Separator  string
+	Attributes []string
+}
+
 type SchemaOptions struct {
 	Ignores          []string
 	AttributeOptions AttributeOptions
+
+	// InheritedIgnores are the ignores from the `defaults` section of the generator config, which are also included in Ignores. Inherited
+	// ignores that don't match an attribute aren't reported, as they aren't expected to apply to every resource and data source.
+	InheritedIgnores []string
 }
 
 type AttributeOptions struct {
 	Aliases   map[string]string
 	Overrides map[string]Override
+
+	// InheritedAliases are the keys of aliases from the `defaults` section of the generator config, which are also included in Aliases.
+	InheritedAliases []string
+	// InheritedOverrides are the keys of overrides from the `defaults` section of the generator config, which are also included in Overrides.
+	// Inherited overrides are applied before all other overrides, and aren't reported when they don't match an attribute.
+	InheritedOverrides []string
 }
 
 type Override struct {
-	Description string
+	Description              string
+	ComputedOptionalRequired schema.ComputedOptionalRequired
+	Sensitive                *bool
+	Type                     string
+	DeprecationMessage       string
 }
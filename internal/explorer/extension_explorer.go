@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+var _ Explorer = extensionExplorer{}
+
+const (
+	// ResourceExtension is an operation-level extension that marks the operation as a resource action, i.e.
+	// `x-terraform-resource: {name: widget, action: create}`. A list can be used to mark multiple resources.
+	ResourceExtension = "x-terraform-resource"
+
+	// DataSourceExtension is an operation-level extension that marks the operation as the read operation of a data source, i.e.
+	// `x-terraform-datasource: {name: widget}`. A list can be used to mark multiple data sources.
+	DataSourceExtension = "x-terraform-datasource"
+)
+
+const (
+	actionCreate = "create"
+	actionRead   = "read"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// extensionAnnotation is the value of a resource or data source extension on an operation.
+type extensionAnnotation struct {
+	Name   string `yaml:"name"`
+	Action string `yaml:"action"`
+}
+
+// annotatedOperation is an operation with a resource or data source extension, along with its location in the OpenAPI spec.
+type annotatedOperation struct {
+	path       string
+	method     string
+	op         *high.Operation
+	annotation extensionAnnotation
+}
+
+func (o annotatedOperation) String() string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(o.method), o.path)
+}
+
+// extensionExplorer is an explorer that reads vendor extensions in an OpenAPI specification to identify resource and data source
+// operations, allowing API teams to annotate their OpenAPI specification instead of maintaining a separate generator config.
+type extensionExplorer struct {
+	spec high.Document
+}
+
+// NewExtensionExplorer returns an explorer that finds resources and data sources from the following operation-level extensions:
+//   - `x-terraform-resource: {name: widget, action: create}` = Create operation for `widget` resource, action is one of
+//     create, read, update, or delete
+//   - `x-terraform-datasource: {name: widget}` = Read operation for `widget` data source
+//
+// The provider name is taken from the `x-terraform-provider-name` extension on the root document or info object, falling
+// back to the `info.title`.
+func NewExtensionExplorer(spec high.Document) Explorer {
+	return extensionExplorer{
+		spec: spec,
+	}
+}
+
+func (e extensionExplorer) FindProvider() (Provider, error) {
+	name := providerNameFromSpec(e.spec)
+	if name == "" {
+		return Provider{}, fmt.Errorf("unable to find provider name, set the '%s' extension or 'info.title'", providerNameExtension)
+	}
+
+	return Provider{
+		Name: name,
+	}, nil
+}
+
+func (e extensionExplorer) FindResources() (map[string]Resource, error) {
+	resources := map[string]Resource{}
+
+	annotatedOps, errResult := e.annotatedOperations(ResourceExtension)
+
+	// Group all resource actions by resource name
+	resourceActions := map[string]map[string]annotatedOperation{}
+	for _, annotatedOp := range annotatedOps {
+		name, action := annotatedOp.annotation.Name, annotatedOp.annotation.Action
+
+		switch action {
+		case actionCreate, actionRead, actionUpdate, actionDelete:
+		default:
+			errResult = errors.Join(errResult, fmt.Errorf("invalid '%s' extension on '%s': invalid action %q - must be 'create', 'read', 'update', or 'delete'", ResourceExtension, annotatedOp, action))
+			continue
+		}
+
+		if _, ok := resourceActions[name]; !ok {
+			resourceActions[name] = map[string]annotatedOperation{}
+		}
+
+		if existingOp, ok := resourceActions[name][action]; ok {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s' has multiple %s operations: '%s' and '%s'", name, action, existingOp, annotatedOp))
+			continue
+		}
+
+		resourceActions[name][action] = annotatedOp
+	}
+
+	for _, name := range util.SortedKeys(resourceActions) {
+		actions := resourceActions[name]
+
+		createOp, hasCreate := actions[actionCreate]
+		readOp, hasRead := actions[actionRead]
+		if !hasCreate || !hasRead {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s' must have a create and read operation", name))
+			continue
+		}
+
+		resources[name] = Resource{
+			CreateOp:         createOp.op,
+			ReadOp:           readOp.op,
+			UpdateOp:         actions[actionUpdate].op,
+			DeleteOp:         actions[actionDelete].op,
+			CommonParameters: e.pathParameters(readOp.path),
+			ReadPath:         readOp.path,
+		}
+	}
+
+	return resources, errResult
+}
+
+func (e extensionExplorer) FindDataSources() (map[string]DataSource, error) {
+	dataSources := map[string]DataSource{}
+
+	annotatedOps, errResult := e.annotatedOperations(DataSourceExtension)
+
+	readOps := map[string]annotatedOperation{}
+	for _, annotatedOp := range annotatedOps {
+		name, action := annotatedOp.annotation.Name, annotatedOp.annotation.Action
+
+		if action != "" && action != actionRead {
+			errResult = errors.Join(errResult, fmt.Errorf("invalid '%s' extension on '%s': invalid action %q - must be 'read'", DataSourceExtension, annotatedOp, action))
+			continue
+		}
+
+		if existingOp, ok := readOps[name]; ok {
+			errResult = errors.Join(errResult, fmt.Errorf("data source '%s' has multiple read operations: '%s' and '%s'", name, existingOp, annotatedOp))
+			continue
+		}
+
+		readOps[name] = annotatedOp
+	}
+
+	for name, readOp := range readOps {
+		dataSources[name] = DataSource{
+			ReadOp:           readOp.op,
+			CommonParameters: e.pathParameters(readOp.path),
+		}
+	}
+
+	return dataSources, errResult
+}
+
+// annotatedOperations returns all operations in the OpenAPI spec with the given extension, in the order they are defined.
+func (e extensionExplorer) annotatedOperations(extension string) ([]annotatedOperation, error) {
+	var result []annotatedOperation
+	var errResult error
+
+	if e.spec.Paths == nil {
+		return nil, nil
+	}
+
+	for pathPair := range orderedmap.Iterate(context.TODO(), e.spec.Paths.PathItems) {
+		for opPair := range orderedmap.Iterate(context.TODO(), pathPair.Value().GetOperations()) {
+			op := opPair.Value()
+			if op == nil || op.Extensions == nil {
+				continue
+			}
+
+			node, ok := op.Extensions.Get(extension)
+			if !ok || node == nil {
+				continue
+			}
+
+			location := annotatedOperation{
+				path:   pathPair.Key(),
+				method: opPair.Key(),
+				op:     op,
+			}
+
+			annotations, err := decodeAnnotations(node)
+			if err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("invalid '%s' extension on '%s': %w", extension, location, err))
+				continue
+			}
+
+			for _, annotation := range annotations {
+				annotatedOp := location
+				annotatedOp.annotation = annotation
+				result = append(result, annotatedOp)
+			}
+		}
+	}
+
+	return result, errResult
+}
+
+// decodeAnnotations decodes an extension value, which is either a single annotation or a list of annotations.
+func decodeAnnotations(node *yaml.Node) ([]extensionAnnotation, error) {
+	var annotations []extensionAnnotation
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		var annotation extensionAnnotation
+		if err := node.Decode(&annotation); err != nil {
+			return nil, err
+		}
+		annotations = append(annotations, annotation)
+	case yaml.SequenceNode:
+		if err := node.Decode(&annotations); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("must be an object or a list of objects")
+	}
+
+	for _, annotation := range annotations {
+		if annotation.Name == "" {
+			return nil, errors.New("must have a 'name' property")
+		}
+	}
+
+	return annotations, nil
+}
+
+func (e extensionExplorer) pathParameters(path string) []*high.Parameter {
+	pathItem := e.spec.Paths.PathItems.GetOrZero(path)
+	if pathItem == nil {
+		return nil
+	}
+
+	return pathItem.Parameters
+}
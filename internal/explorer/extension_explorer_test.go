@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+)
+
+// testOperationIds is a simplified view of an explorer resource or data source, used to compare which operations were found.
+type testOperationIds struct {
+	Create           string
+	Read             string
+	Update           string
+	Delete           string
+	CommonParameters []string
+}
+
+func operationId(op *high.Operation) string {
+	if op == nil {
+		return ""
+	}
+
+	return op.OperationId
+}
+
+func parameterNames(params []*high.Parameter) []string {
+	var names []string
+	for _, param := range params {
+		names = append(names, param.Name)
+	}
+
+	return names
+}
+
+func Test_ExtensionExplorer_FindResources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		paths          string
+		want           map[string]testOperationIds
+		expectedErrMsg string
+	}{
+		"valid CRUD ops": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {name: widget, action: create}
+  /widgets/{widget_id}:
+    parameters:
+      - name: widget_id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: read_widget
+      x-terraform-resource: {name: widget, action: read}
+    patch:
+      operationId: update_widget
+      x-terraform-resource: {name: widget, action: update}
+    delete:
+      operationId: delete_widget
+      x-terraform-resource: {name: widget, action: delete}
+    put:
+      operationId: not_annotated`,
+			want: map[string]testOperationIds{
+				"widget": {
+					Create:           "create_widget",
+					Read:             "read_widget",
+					Update:           "update_widget",
+					Delete:           "delete_widget",
+					CommonParameters: []string{"widget_id"},
+				},
+			},
+		},
+		"list of annotations": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource:
+        - {name: widget, action: create}
+        - {name: widget_v2, action: create}
+  /widgets/{widget_id}:
+    get:
+      operationId: read_widget
+      x-terraform-resource:
+        - name: widget
+          action: read
+        - name: widget_v2
+          action: read`,
+			want: map[string]testOperationIds{
+				"widget": {
+					Create: "create_widget",
+					Read:   "read_widget",
+				},
+				"widget_v2": {
+					Create: "create_widget",
+					Read:   "read_widget",
+				},
+			},
+		},
+		"missing read op": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {name: widget, action: create}`,
+			want:           map[string]testOperationIds{},
+			expectedErrMsg: "resource 'widget' must have a create and read operation",
+		},
+		"invalid action": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {name: widget, action: create}
+  /widgets/{widget_id}:
+    get:
+      operationId: read_widget
+      x-terraform-resource: {name: widget, action: read}
+    put:
+      operationId: replace_widget
+      x-terraform-resource: {name: widget, action: replace}`,
+			want: map[string]testOperationIds{
+				"widget": {
+					Create: "create_widget",
+					Read:   "read_widget",
+				},
+			},
+			expectedErrMsg: `invalid 'x-terraform-resource' extension on 'PUT /widgets/{widget_id}': invalid action "replace" - must be 'create', 'read', 'update', or 'delete'`,
+		},
+		"duplicate action": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {name: widget, action: create}
+  /widgets/{widget_id}:
+    get:
+      operationId: read_widget
+      x-terraform-resource: {name: widget, action: read}
+    put:
+      operationId: create_widget_put
+      x-terraform-resource: {name: widget, action: create}`,
+			want: map[string]testOperationIds{
+				"widget": {
+					Create: "create_widget",
+					Read:   "read_widget",
+				},
+			},
+			expectedErrMsg: "resource 'widget' has multiple create operations: 'POST /widgets' and 'PUT /widgets/{widget_id}'",
+		},
+		"missing name": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {action: create}`,
+			want:           map[string]testOperationIds{},
+			expectedErrMsg: "invalid 'x-terraform-resource' extension on 'POST /widgets': must have a 'name' property",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec, err := buildExtensionTestSpec(testCase.paths)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resources, err := explorer.NewExtensionExplorer(spec).FindResources()
+			if testCase.expectedErrMsg == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if testCase.expectedErrMsg != "" && (err == nil || err.Error() != testCase.expectedErrMsg) {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedErrMsg, err)
+			}
+
+			got := map[string]testOperationIds{}
+			for name, resource := range resources {
+				got[name] = testOperationIds{
+					Create:           operationId(resource.CreateOp),
+					Read:             operationId(resource.ReadOp),
+					Update:           operationId(resource.UpdateOp),
+					Delete:           operationId(resource.DeleteOp),
+					CommonParameters: parameterNames(resource.CommonParameters),
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ExtensionExplorer_FindDataSources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		paths          string
+		want           map[string]testOperationIds
+		expectedErrMsg string
+	}{
+		"valid read ops": {
+			paths: `
+  /widgets:
+    get:
+      operationId: list_widgets
+      x-terraform-datasource: {name: widgets}
+  /widgets/{widget_id}:
+    parameters:
+      - name: widget_id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: read_widget
+      x-terraform-datasource: {name: widget, action: read}`,
+			want: map[string]testOperationIds{
+				"widgets": {
+					Read: "list_widgets",
+				},
+				"widget": {
+					Read:             "read_widget",
+					CommonParameters: []string{"widget_id"},
+				},
+			},
+		},
+		"invalid action": {
+			paths: `
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-datasource: {name: widget, action: create}`,
+			want:           map[string]testOperationIds{},
+			expectedErrMsg: `invalid 'x-terraform-datasource' extension on 'POST /widgets': invalid action "create" - must be 'read'`,
+		},
+		"duplicate read op": {
+			paths: `
+  /widgets:
+    get:
+      operationId: list_widgets
+      x-terraform-datasource: {name: widget}
+  /widgets/{widget_id}:
+    get:
+      operationId: read_widget
+      x-terraform-datasource: {name: widget}`,
+			want: map[string]testOperationIds{
+				"widget": {
+					Read: "list_widgets",
+				},
+			},
+			expectedErrMsg: "data source 'widget' has multiple read operations: 'GET /widgets' and 'GET /widgets/{widget_id}'",
+		},
+		"invalid extension value": {
+			paths: `
+  /widgets:
+    get:
+      operationId: list_widgets
+      x-terraform-datasource: widgets`,
+			want:           map[string]testOperationIds{},
+			expectedErrMsg: "invalid 'x-terraform-datasource' extension on 'GET /widgets': must be an object or a list of objects",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec, err := buildExtensionTestSpec(testCase.paths)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dataSources, err := explorer.NewExtensionExplorer(spec).FindDataSources()
+			if testCase.expectedErrMsg == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if testCase.expectedErrMsg != "" && (err == nil || err.Error() != testCase.expectedErrMsg) {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedErrMsg, err)
+			}
+
+			got := map[string]testOperationIds{}
+			for name, dataSource := range dataSources {
+				got[name] = testOperationIds{
+					Read:             operationId(dataSource.ReadOp),
+					CommonParameters: parameterNames(dataSource.CommonParameters),
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ExtensionExplorer_FindProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		info        string
+		want        string
+		expectedErr bool
+	}{
+		"provider name extension": {
+			info: `
+  title: Widget API
+  x-terraform-provider-name: widgets`,
+			want: "widgets",
+		},
+		"info title": {
+			info: `
+  title: Widget API`,
+			want: "widget_api",
+		},
+		"no provider name": {
+			info: `
+  title: ""`,
+			expectedErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec, err := buildTestSpec(fmt.Sprintf("openapi: 3.1.0\ninfo:%s\npaths: {}\n", testCase.info))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			provider, err := explorer.NewExtensionExplorer(spec).FindProvider()
+			if testCase.expectedErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(provider.Name, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_LayeredExplorer(t *testing.T) {
+	t.Parallel()
+
+	spec, err := buildExtensionTestSpec(`
+  /widgets:
+    post:
+      operationId: create_widget
+      x-terraform-resource: {name: widget, action: create}
+    get:
+      operationId: list_widgets
+      x-terraform-datasource: {name: widgets}
+  /widgets/{widget_id}:
+    get:
+      operationId: read_widget
+      x-terraform-resource:
+        - {name: widget, action: read}
+        - {name: gadget, action: read}
+    put:
+      operationId: update_widget
+      x-terraform-resource: {name: gadget, action: create}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Config{
+		Provider: config.Provider{
+			Name: "from_config",
+		},
+		Resources: map[string]config.Resource{
+			"widget": {
+				Create: &config.OpenApiSpecLocation{Path: "/widgets/{widget_id}", Method: "PUT"},
+				Read:   &config.OpenApiSpecLocation{Path: "/widgets/{widget_id}", Method: "GET"},
+			},
+		},
+	}
+
+	layeredExplorer := explorer.NewLayeredExplorer(explorer.NewConfigExplorer(spec, cfg), explorer.NewExtensionExplorer(spec))
+
+	provider, err := layeredExplorer.FindProvider()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(provider.Name, "from_config"); diff != "" {
+		t.Errorf("unexpected provider difference: %s", diff)
+	}
+
+	resources, err := layeredExplorer.FindResources()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotResources := map[string]testOperationIds{}
+	for name, resource := range resources {
+		gotResources[name] = testOperationIds{
+			Create: operationId(resource.CreateOp),
+			Read:   operationId(resource.ReadOp),
+		}
+	}
+
+	wantResources := map[string]testOperationIds{
+		// The config explorer takes priority over the extension explorer
+		"widget": {
+			Create: "update_widget",
+			Read:   "read_widget",
+		},
+		"gadget": {
+			Create: "update_widget",
+			Read:   "read_widget",
+		},
+	}
+	if diff := cmp.Diff(gotResources, wantResources); diff != "" {
+		t.Errorf("unexpected resources difference: %s", diff)
+	}
+
+	dataSources, err := layeredExplorer.FindDataSources()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := dataSources["widgets"]; !ok || len(dataSources) != 1 {
+		t.Errorf("expected 'widgets' data source from extensions, got: %v", dataSources)
+	}
+}
+
+func buildExtensionTestSpec(paths string) (high.Document, error) {
+	return buildTestSpec(fmt.Sprintf("openapi: 3.1.0\ninfo:\n  title: Widget API\npaths:%s\n", paths))
+}
+
+func buildTestSpec(testOAS string) (high.Document, error) {
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		return high.Document{}, fmt.Errorf("unexpected error parsing test OAS: %w", err)
+	}
+
+	testOASModel, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		var errResult error
+		for _, err := range errs {
+			errResult = errors.Join(errResult, err)
+		}
+		return high.Document{}, fmt.Errorf("unexpected error building test OAS: %w", errResult)
+	}
+
+	return testOASModel.Model, nil
+}
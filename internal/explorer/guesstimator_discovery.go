@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+)
+
+// Discovery is a draft generator config built by the guesstimator explorer, containing the location of every operation for
+// the resources and data sources found, as well as every resource candidate that was evaluated.
+type Discovery struct {
+	Provider    config.Provider
+	Resources   map[string]config.Resource
+	DataSources map[string]config.DataSource
+
+	// ResourceCandidates are all groups of API paths that were evaluated as a resource, with the reasons they were accepted or rejected.
+	ResourceCandidates map[string]ResourceCandidate
+}
+
+// ResourceCandidate is a group of API paths that was evaluated as a resource by the guesstimator explorer.
+type ResourceCandidate struct {
+	// Resource contains any operations that were found, which are partially populated if the candidate was rejected.
+	Resource config.Resource
+
+	// Accepted is true if the candidate meets all requirements of a resource and is included in Discovery.Resources.
+	Accepted bool
+
+	// Reasons describes which pattern was used if the candidate was accepted, i.e. "created with PUT on identity path '/pets/{id}'",
+	// or each requirement that was not met if the candidate was rejected.
+	Reasons []string
+
+	// NameHints are possible resource names from the operationId and tags of the create and read operations.
+	NameHints []string
+}
+
+// DiscoverGeneratorConfig uses the same RESTful conventions as [NewGuesstimatorExplorer] to find resources and data sources,
+// returning the location of each operation so a draft generator config can be created.
+func DiscoverGeneratorConfig(spec high.Document) Discovery {
+	e := guesstimatorExplorer{spec: spec}
+
+	provider, _ := e.FindProvider()
+
+	discovery := Discovery{
+		Provider: config.Provider{
+			Name: provider.Name,
+		},
+		Resources:          map[string]config.Resource{},
+		DataSources:        map[string]config.DataSource{},
+		ResourceCandidates: map[string]ResourceCandidate{},
+	}
+
+	groups := e.groupPathItems()
+	names := resourceNames(groups)
+	for groupName, group := range groups {
+		if len(group.IdentityOps) == 0 && len(group.CollectionOps) == 0 {
+			continue
+		}
+
+		name, ok := names[groupName]
+		if !ok {
+			continue
+		}
+
+		guess := group.guessResource()
+		nameHints := guess.nameHints()
+
+		candidate := ResourceCandidate{
+			Resource: config.Resource{
+				Create: guess.Create.location(),
+				Read:   guess.Read.location(),
+				Update: guess.Update.location(),
+				Delete: guess.Delete.location(),
+			},
+			Accepted:  guess.Accepted,
+			Reasons:   guess.Reasons,
+			NameHints: nameHints,
+		}
+
+		discovery.ResourceCandidates[name] = candidate
+		if candidate.Accepted {
+			discovery.Resources[name] = candidate.Resource
+		}
+
+		if read := group.identityOp("get"); read != nil {
+			discovery.DataSources[name+"_by_id"] = config.DataSource{Read: read.location()}
+		}
+
+		if read := group.collectionOp("get"); read != nil {
+			discovery.DataSources[name+"_collection"] = config.DataSource{Read: read.location()}
+		}
+	}
+
+	return discovery
+}
+
+func (o *guessedOperation) location() *config.OpenApiSpecLocation {
+	if o == nil {
+		return nil
+	}
+
+	return &config.OpenApiSpecLocation{
+		Path:   o.Path,
+		Method: o.Method,
+	}
+}
@@ -17,7 +17,7 @@ import (
 var _ Explorer = guesstimatorExplorer{}
 
 // guesstimatorExplorer is an experimental explorer that reads an OpenAPI specification without any configuration and attempts to
-// discover resources and data sources based on a naming convention. It's not currently in-use in the OpenAPI spec generator.
+// discover resources and data sources based on a naming convention. It's used by the `discover` command to draft a generator config.
 type guesstimatorExplorer struct {
 	spec high.Document
 }
@@ -32,6 +32,18 @@ type resourceOperations struct {
 
 	// CollectionOps are operations (GET, PUT, POST, DELETE, etc.) on a path that don't end with a parameter: /path
 	CollectionOps map[string]*high.Operation
+
+	// IdentityPath is the path of the identity operations: /path/{id}
+	IdentityPath string
+
+	// CollectionPath is the path of the collection operations: /path
+	CollectionPath string
+
+	// IdentityParameters are the parameters defined on the path item of the identity path
+	IdentityParameters []*high.Parameter
+
+	// CollectionParameters are the parameters defined on the path item of the collection path
+	CollectionParameters []*high.Parameter
 }
 
 // As the name suggests, the Guesstimator evaluates an OpenAPIv3 spec and will return
@@ -46,13 +58,24 @@ type resourceOperations struct {
 //   - PUT /org/{org_id}/users/{id} = Update operation for `org_users` resource
 //   - DELETE /org/{org_id}/users/{id} = Delete operation for `org_users` resource
 //
+// The following variations are also valid Resources:
+//   - PATCH /org/{org_id}/users/{id} = Update operation, if there is no PUT identity operation
+//   - PUT /org/{org_id}/users/{id} = Create and update operation, if there is no POST operation (PUT-to-create)
+//   - GET + PUT/PATCH /org/{org_id}/settings = Singleton `org_settings` resource, if there are no identity operations. A DELETE
+//     operation on the same path is optional.
+//
+// Nested sub-resources, such as /org/{org_id}/users/{user_id}/keys/{id}, are named with all preceding paths, i.e. `org_users_keys`.
+// If no name can be derived from the path, or the path ends with a generic segment such as `/api/v1/items/{id}`, the operationId
+// of the create operation or the tags of the create and read operations are used as a naming hint. A naming hint that another
+// resource already uses is ignored.
+//
 // FindDataSources will group API paths together into collection operations and identity operations, then use the HTTP method to
 // determine how to map to a terraform data source. A valid DataSource has a GET identity operation or a GET collection operation.
-// The name of the DataSource is a combination of the preceding paths, excluding any path parameters, with an added suffix of "_collection"
-// for the collection operation of a DataSource.
+// The name of the DataSource is the same as the Resource name of the group, including any naming hint, with an added suffix of "_by_id"
+// for the identity operation or "_collection" for the collection operation.
 // An example of two valid DataSources would be:
 //   - GET /org/{org_id}/users = Read operation for `org_users_collection` data source
-//   - GET /org/{org_id}/users/{id} = Read operation for `org_users` data source
+//   - GET /org/{org_id}/users/{id} = Read operation for `org_users_by_id` data source
 //
 // [RESTful conventions]: https://swagger.io/resources/articles/best-practices-in-api-design/
 func NewGuesstimatorExplorer(spec high.Document) Explorer {
@@ -61,12 +84,6 @@ func NewGuesstimatorExplorer(spec high.Document) Explorer {
 	}
 }
 
-func (e guesstimatorExplorer) FindProvider() (Provider, error) {
-	return Provider{
-		Name: "guesstimator_placeholder",
-	}, nil
-}
-
 // Reference - [Terraform Resource Behavior]
 //
 // [Terraform Resource Behavior]: https://developer.hashicorp.com/terraform/language/resources/behavior#how-terraform-applies-a-configuration
@@ -74,26 +91,25 @@ func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 	resourcesMap := map[string]Resource{}
 
 	groupedResourceOperations := e.groupPathItems()
-	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
+	names := resourceNames(groupedResourceOperations)
+	for groupName, group := range groupedResourceOperations {
+		guess := group.guessResource()
+		if !guess.Accepted {
 			continue
 		}
 
-		if group.IdentityOps["get"] == nil || group.IdentityOps["delete"] == nil || group.CollectionOps["post"] == nil {
+		name, ok := names[groupName]
+		if !ok {
 			continue
 		}
 
-		// Fallback to POST on identity
-		createOp := group.CollectionOps["post"]
-		if createOp == nil {
-			createOp = group.IdentityOps["post"]
-		}
-
 		resourcesMap[name] = Resource{
-			CreateOp: createOp,
-			ReadOp:   group.IdentityOps["get"],
-			UpdateOp: group.IdentityOps["put"],
-			DeleteOp: group.IdentityOps["delete"],
+			CreateOp:         guess.Create.operation(),
+			ReadOp:           guess.Read.operation(),
+			UpdateOp:         guess.Update.operation(),
+			DeleteOp:         guess.Delete.operation(),
+			CommonParameters: guess.Read.CommonParameters,
+			ReadPath:         guess.Read.Path,
 		}
 	}
 
@@ -107,18 +123,26 @@ func (e guesstimatorExplorer) FindDataSources() (map[string]DataSource, error) {
 	dataSourcesMap := map[string]DataSource{}
 
 	groupedResourceOperations := e.groupPathItems()
-	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
+	names := resourceNames(groupedResourceOperations)
+	for groupName, group := range groupedResourceOperations {
+		name, ok := names[groupName]
+		if !ok {
 			continue
 		}
 
 		if group.IdentityOps["get"] != nil {
 			// Combine all schemas into something that can be translated to framework IR
-			dataSourcesMap[name+"_by_id"] = DataSource{ReadOp: group.IdentityOps["get"]}
+			dataSourcesMap[name+"_by_id"] = DataSource{
+				ReadOp:           group.IdentityOps["get"],
+				CommonParameters: group.IdentityParameters,
+			}
 		}
 
 		if group.CollectionOps["get"] != nil {
-			dataSourcesMap[name+"_collection"] = DataSource{ReadOp: group.CollectionOps["get"]}
+			dataSourcesMap[name+"_collection"] = DataSource{
+				ReadOp:           group.CollectionOps["get"],
+				CommonParameters: group.CollectionParameters,
+			}
 		}
 	}
 
@@ -133,22 +157,32 @@ func (e guesstimatorExplorer) groupPathItems() map[string]resourceOperations {
 	for pair := range orderedmap.Iterate(context.TODO(), e.spec.Paths.PathItems) {
 		resource, isIdentity := convertPathToResourceName(pair.Key())
 
-		_, ok := groups[resource]
+		group, ok := groups[resource]
 		if !ok {
-			groups[resource] = resourceOperations{
+			group = resourceOperations{
 				IdentityOps:   map[string]*high.Operation{},
 				CollectionOps: map[string]*high.Operation{},
 			}
 		}
 
+		if isIdentity {
+			group.IdentityPath = pair.Key()
+			group.IdentityParameters = pair.Value().Parameters
+		} else {
+			group.CollectionPath = pair.Key()
+			group.CollectionParameters = pair.Value().Parameters
+		}
+
 		ops := pair.Value().GetOperations()
 		for opPair := range orderedmap.Iterate(context.TODO(), ops) {
 			if isIdentity {
-				groups[resource].IdentityOps[opPair.Key()] = opPair.Value()
+				group.IdentityOps[opPair.Key()] = opPair.Value()
 			} else {
-				groups[resource].CollectionOps[opPair.Key()] = opPair.Value()
+				group.CollectionOps[opPair.Key()] = opPair.Value()
 			}
 		}
+
+		groups[resource] = group
 	}
 
 	return groups
@@ -4,12 +4,19 @@
 package explorer_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
 
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func Test_GuesstimatorExplorer_FindResources(t *testing.T) {
@@ -55,6 +62,68 @@ func Test_GuesstimatorExplorer_FindResources(t *testing.T) {
 			}),
 			expectedResources: []string{"verycool_verynice_resources"},
 		},
+		"valid resource combo - generic path named from operationId": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{OperationId: "create_widget"},
+				},
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"widget"},
+		},
+		"valid resource combo - generic paths with the same tag keep path names": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/v1/items": {
+					Post: &high.Operation{Tags: []string{"Widgets"}},
+				},
+				"/v1/items/{item_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+				"/v2/records": {
+					Post: &high.Operation{Tags: []string{"Widgets"}},
+				},
+				"/v2/records/{record_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"v1_items", "v2_records"},
+		},
+		"valid resource combo - name hint matching another path name keeps path name": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/widgets": {
+					Post: &high.Operation{},
+				},
+				"/widgets/{widget_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+				"/api/items": {
+					Post: &high.Operation{OperationId: "createWidgets"},
+				},
+				"/api/items/{item_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"widgets", "api_items"},
+		},
+		"valid resource combo - specific path keeps path name": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/users": {
+					Post: &high.Operation{OperationId: "createAccount"},
+				},
+				"/users/{user_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"users"},
+		},
 		"invalid resource combo - POST,DELETEbyID": {
 			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
 				"/resources": {
@@ -86,6 +155,67 @@ func Test_GuesstimatorExplorer_FindResources(t *testing.T) {
 			}),
 			expectedResources: []string{},
 		},
+		"valid resource combo - PATCH update": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"resources"},
+		},
+		"valid resource combo - PUT-to-create": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"resources"},
+		},
+		"valid singleton resource combo": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/org/{org_id}/settings": {
+					Get: &high.Operation{},
+					Put: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"org_settings"},
+		},
+		"valid nested sub-resource combo": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/users": {
+					Post: &high.Operation{},
+				},
+				"/users/{user_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+				"/users/{user_id}/keys": {
+					Post: &high.Operation{},
+				},
+				"/users/{user_id}/keys/{key_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"users", "users_keys"},
+		},
+		"invalid singleton resource combo - POST collection": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/org/{org_id}/settings": {
+					Get:  &high.Operation{},
+					Put:  &high.Operation{},
+					Post: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{},
+		},
 		"invalid resource combo - no ops": {
 			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
 				"/resources":               {},
@@ -120,6 +250,134 @@ func Test_GuesstimatorExplorer_FindResources(t *testing.T) {
 	}
 }
 
+func Test_GuesstimatorExplorer_FindResources_CommonParameters(t *testing.T) {
+	t.Parallel()
+
+	identityParams := []*high.Parameter{{Name: "resource_id", In: "path"}}
+	collectionParams := []*high.Parameter{{Name: "filter", In: "query"}}
+
+	explorer := explorer.NewGuesstimatorExplorer(high.Document{Paths: &high.Paths{PathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+		"/resources": {
+			Get:        &high.Operation{},
+			Post:       &high.Operation{},
+			Parameters: collectionParams,
+		},
+		"/resources/{resource_id}": {
+			Get:        &high.Operation{},
+			Delete:     &high.Operation{},
+			Parameters: identityParams,
+		},
+	})}})
+
+	resources, err := explorer.FindResources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	if diff := cmp.Diff(resources["resources"].CommonParameters, identityParams, cmpopts.IgnoreUnexported(high.Parameter{})); diff != "" {
+		t.Errorf("unexpected resource common parameters: %s", diff)
+	}
+
+	dataSources, err := explorer.FindDataSources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	if diff := cmp.Diff(dataSources["resources_by_id"].CommonParameters, identityParams, cmpopts.IgnoreUnexported(high.Parameter{})); diff != "" {
+		t.Errorf("unexpected by_id data source common parameters: %s", diff)
+	}
+
+	if diff := cmp.Diff(dataSources["resources_collection"].CommonParameters, collectionParams, cmpopts.IgnoreUnexported(high.Parameter{})); diff != "" {
+		t.Errorf("unexpected collection data source common parameters: %s", diff)
+	}
+}
+
+func Test_GuesstimatorExplorer_FindProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document           high.Document
+		expectedName       string
+		expectedAttributes map[string]string
+	}{
+		"no info": {
+			document:     high.Document{},
+			expectedName: "guesstimator_placeholder",
+		},
+		"name from info title": {
+			document: high.Document{
+				Info: &base.Info{Title: "Swagger Petstore - OpenAPI 3.0"},
+			},
+			expectedName: "swagger_petstore_open_api_3_0",
+		},
+		"name from extension": {
+			document: high.Document{
+				Info: &base.Info{
+					Title:      "Swagger Petstore - OpenAPI 3.0",
+					Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{"x-terraform-provider-name": {Kind: yaml.ScalarNode, Value: "petstore"}}),
+				},
+			},
+			expectedName: "petstore",
+		},
+		"schema from servers and security schemes": {
+			document: high.Document{
+				Info: &base.Info{Title: "Example"},
+				Servers: []*high.Server{
+					{URL: "https://api.example.com/v1"},
+				},
+				Components: &high.Components{
+					SecuritySchemes: orderedmap.ToOrderedMap(map[string]*high.SecurityScheme{
+						"apiKeyAuth": {Type: "apiKey", Name: "X-API-Key", In: "header"},
+						"bearerAuth": {Type: "http", Scheme: "bearer"},
+						"basicAuth":  {Type: "http", Scheme: "basic"},
+						"oauth":      {Type: "oauth2"},
+					}),
+				},
+			},
+			expectedName: "example",
+			expectedAttributes: map[string]string{
+				"endpoint":     "",
+				"api_key_auth": "password",
+				"token":        "password",
+				"username":     "",
+				"password":     "password",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider, err := explorer.NewGuesstimatorExplorer(testCase.document).FindProvider()
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if provider.Name != testCase.expectedName {
+				t.Errorf("expected provider name %q, got %q", testCase.expectedName, provider.Name)
+			}
+
+			if testCase.expectedAttributes == nil {
+				if provider.SchemaProxy != nil {
+					t.Fatalf("expected no provider schema")
+				}
+				return
+			}
+
+			gotAttributes := map[string]string{}
+			for pair := range orderedmap.Iterate(context.TODO(), provider.SchemaProxy.Schema().Properties) {
+				gotAttributes[pair.Key()] = pair.Value().Schema().Format
+			}
+
+			if diff := cmp.Diff(gotAttributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected provider attributes: %s", diff)
+			}
+		})
+	}
+}
+
 func Test_GuesstimatorExplorer_FindDataSources(t *testing.T) {
 	t.Parallel()
 
@@ -162,6 +420,19 @@ func Test_GuesstimatorExplorer_FindDataSources(t *testing.T) {
 			}),
 			expectedDataSources: []string{"verycool_verynice_resources_collection"},
 		},
+		"generic path named from operationId, same as the resource": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/api/v1/items": {
+					Get:  &high.Operation{},
+					Post: &high.Operation{OperationId: "createWidget"},
+				},
+				"/api/v1/items/{item_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedDataSources: []string{"widget_collection", "widget_by_id"},
+		},
 		"invalid data source combo - no matching ops": {
 			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
 				"/resources": {
@@ -218,3 +489,417 @@ func Test_GuesstimatorExplorer_FindDataSources(t *testing.T) {
 		})
 	}
 }
+
+func Test_DiscoverGeneratorConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pathItems *orderedmap.Map[string, *high.PathItem]
+		want      explorer.Discovery
+	}{
+		"valid resource and data sources": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/things": {
+					Get: &high.Operation{},
+					Post: &high.Operation{
+						OperationId: "createThing",
+						Tags:        []string{"Things"},
+					},
+				},
+				"/things/{thing_id}": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"things": {
+						Create: &config.OpenApiSpecLocation{Path: "/things", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "PUT"},
+						Delete: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"things_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+					},
+					"things_collection": {
+						Read: &config.OpenApiSpecLocation{Path: "/things", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"things": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/things", Method: "POST"},
+							Read:   &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+							Update: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "PUT"},
+							Delete: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with POST on collection path '/things'",
+						},
+						NameHints: []string{"thing", "things"},
+					},
+				},
+			},
+		},
+		"valid resource - PUT-to-create and PATCH update": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"resources": {
+						Create: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+						Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+						Delete: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"resources_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"resources": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+							Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+							Update: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+							Delete: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with PUT on identity path '/resources/{resource_id}' (PUT-to-create)",
+						},
+					},
+				},
+			},
+		},
+		"valid resource - nested singleton": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/org/{org_id}/settings": {
+					Get:   &high.Operation{},
+					Patch: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"org_settings": {
+						Create: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+						Read:   &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"org_settings_collection": {
+						Read: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"org_settings": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+							Read:   &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "GET"},
+							Update: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"singleton resource, created and updated with PATCH on '/org/{org_id}/settings'",
+						},
+					},
+				},
+			},
+		},
+		"name hint used when path has no name": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/{id}": {
+					Get:    &high.Operation{Tags: []string{"Widgets"}},
+					Put:    &high.Operation{OperationId: "put-widget"},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"widget": {
+						Create: &config.OpenApiSpecLocation{Path: "/{id}", Method: "PUT"},
+						Read:   &config.OpenApiSpecLocation{Path: "/{id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/{id}", Method: "PUT"},
+						Delete: &config.OpenApiSpecLocation{Path: "/{id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"widget_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/{id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"widget": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/{id}", Method: "PUT"},
+							Read:   &config.OpenApiSpecLocation{Path: "/{id}", Method: "GET"},
+							Update: &config.OpenApiSpecLocation{Path: "/{id}", Method: "PUT"},
+							Delete: &config.OpenApiSpecLocation{Path: "/{id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with PUT on identity path '/{id}' (PUT-to-create)",
+						},
+						NameHints: []string{"widget", "widgets"},
+					},
+				},
+			},
+		},
+		"name hint used when path segment is generic": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/api/v1/items": {
+					Post: &high.Operation{
+						OperationId: "createWidget",
+						Tags:        []string{"Widgets"},
+					},
+				},
+				"/api/v1/items/{item_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"widget": {
+						Create: &config.OpenApiSpecLocation{Path: "/api/v1/items", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/api/v1/items/{item_id}", Method: "GET"},
+						Delete: &config.OpenApiSpecLocation{Path: "/api/v1/items/{item_id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"widget_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/api/v1/items/{item_id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"widget": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/api/v1/items", Method: "POST"},
+							Read:   &config.OpenApiSpecLocation{Path: "/api/v1/items/{item_id}", Method: "GET"},
+							Delete: &config.OpenApiSpecLocation{Path: "/api/v1/items/{item_id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with POST on collection path '/api/v1/items'",
+						},
+						NameHints: []string{"widget", "widgets"},
+					},
+				},
+			},
+		},
+		"name hint from tag used when path segment is generic": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/v2/{id}": {
+					Get:    &high.Operation{Tags: []string{"Gadgets"}},
+					Put:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"gadgets": {
+						Create: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "PUT"},
+						Read:   &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "PUT"},
+						Delete: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"gadgets_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"gadgets": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "PUT"},
+							Read:   &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "GET"},
+							Update: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "PUT"},
+							Delete: &config.OpenApiSpecLocation{Path: "/v2/{id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with PUT on identity path '/v2/{id}' (PUT-to-create)",
+						},
+						NameHints: []string{"gadgets"},
+					},
+				},
+			},
+		},
+		"name hints ignored when generic paths share a tag": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/v1/items": {
+					Post: &high.Operation{Tags: []string{"Widgets"}},
+				},
+				"/v1/items/{item_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+				"/v2/records": {
+					Post: &high.Operation{Tags: []string{"Widgets"}},
+				},
+				"/v2/records/{record_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{
+					"v1_items": {
+						Create: &config.OpenApiSpecLocation{Path: "/v1/items", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/v1/items/{item_id}", Method: "GET"},
+						Delete: &config.OpenApiSpecLocation{Path: "/v1/items/{item_id}", Method: "DELETE"},
+					},
+					"v2_records": {
+						Create: &config.OpenApiSpecLocation{Path: "/v2/records", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/v2/records/{record_id}", Method: "GET"},
+						Delete: &config.OpenApiSpecLocation{Path: "/v2/records/{record_id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"v1_items_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/v1/items/{item_id}", Method: "GET"},
+					},
+					"v2_records_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/v2/records/{record_id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"v1_items": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/v1/items", Method: "POST"},
+							Read:   &config.OpenApiSpecLocation{Path: "/v1/items/{item_id}", Method: "GET"},
+							Delete: &config.OpenApiSpecLocation{Path: "/v1/items/{item_id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with POST on collection path '/v1/items'",
+						},
+						NameHints: []string{"widgets"},
+					},
+					"v2_records": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/v2/records", Method: "POST"},
+							Read:   &config.OpenApiSpecLocation{Path: "/v2/records/{record_id}", Method: "GET"},
+							Delete: &config.OpenApiSpecLocation{Path: "/v2/records/{record_id}", Method: "DELETE"},
+						},
+						Accepted: true,
+						Reasons: []string{
+							"created with POST on collection path '/v2/records'",
+						},
+						NameHints: []string{"widgets"},
+					},
+				},
+			},
+		},
+		"rejected resource": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{},
+				},
+				"/empty": {},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{},
+				DataSources: map[string]config.DataSource{
+					"resources_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"resources": {
+						Resource: config.Resource{
+							Create: &config.OpenApiSpecLocation{Path: "/resources", Method: "POST"},
+							Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+						},
+						Reasons: []string{
+							"no delete operation, expected DELETE on '/resources/{resource_id}'",
+						},
+					},
+				},
+			},
+		},
+		"rejected resource - no identity path": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/login": {
+					Get: &high.Operation{},
+				},
+			}),
+			want: explorer.Discovery{
+				Provider: config.Provider{
+					Name: "guesstimator_placeholder",
+				},
+				Resources: map[string]config.Resource{},
+				DataSources: map[string]config.DataSource{
+					"login_collection": {
+						Read: &config.OpenApiSpecLocation{Path: "/login", Method: "GET"},
+					},
+				},
+				ResourceCandidates: map[string]explorer.ResourceCandidate{
+					"login": {
+						Reasons: []string{
+							"no create operation, expected POST on '/login' or PUT on identity path (not found)",
+							"no read operation, expected GET on identity path (not found)",
+							"no delete operation, expected DELETE on identity path (not found)",
+							"not a singleton resource, expected GET and PUT or PATCH on '/login'",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := explorer.DiscoverGeneratorConfig(high.Document{Paths: &high.Paths{PathItems: testCase.pathItems}})
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
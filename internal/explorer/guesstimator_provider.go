@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+const (
+	// providerNameExtension can be set on the root document or the info object to explicitly set the provider name
+	providerNameExtension = "x-terraform-provider-name"
+
+	// placeholderProviderName is used when no provider name can be derived from the OpenAPI spec
+	placeholderProviderName = "guesstimator_placeholder"
+)
+
+// This regex matches any sequence of characters that are not alphanumeric, which are treated as word separators in the info title
+//   - Swagger Petstore - OpenAPI 3.0 = Swagger_Petstore_OpenAPI_3_0
+var titleSeparatorRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// FindProvider derives the provider name from the `x-terraform-provider-name` extension or the `info.title` of the OpenAPI spec. The
+// provider schema is built from the first server URL (`endpoint`) and the supported security schemes:
+//   - apiKey = A sensitive string attribute, named after the security scheme
+//   - http bearer = A sensitive `token` string attribute
+//   - http basic = A `username` string attribute and a sensitive `password` string attribute
+//
+// All provider attributes are optional, as they can also be set with environment variables by the provider.
+func (e guesstimatorExplorer) FindProvider() (Provider, error) {
+	provider := Provider{
+		Name: e.providerName(),
+	}
+
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+
+	if len(e.spec.Servers) > 0 && e.spec.Servers[0] != nil && e.spec.Servers[0].URL != "" {
+		properties.Set("endpoint", base.CreateSchemaProxy(&base.Schema{
+			Type:        []string{util.OAS_type_string},
+			Description: fmt.Sprintf("The API endpoint, defaults to '%s'", e.spec.Servers[0].URL),
+		}))
+	}
+
+	if e.spec.Components != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), e.spec.Components.SecuritySchemes) {
+			addSecuritySchemeProperties(properties, pair.Key(), pair.Value())
+		}
+	}
+
+	if properties.Len() == 0 {
+		return provider, nil
+	}
+
+	provider.SchemaProxy = base.CreateSchemaProxy(&base.Schema{
+		Type:       []string{util.OAS_type_object},
+		Properties: properties,
+	})
+
+	return provider, nil
+}
+
+func (e guesstimatorExplorer) providerName() string {
+	name := providerNameFromSpec(e.spec)
+	if name == "" {
+		return placeholderProviderName
+	}
+
+	return name
+}
+
+// providerNameFromSpec returns the provider name from the `x-terraform-provider-name` extension on the root document or info
+// object, falling back to the `info.title` converted to a Terraform identifier. Returns an empty string if neither is found.
+func providerNameFromSpec(spec high.Document) string {
+	if name := extensionString(spec.Extensions, providerNameExtension); name != "" {
+		return name
+	}
+
+	if spec.Info == nil {
+		return ""
+	}
+
+	if name := extensionString(spec.Info.Extensions, providerNameExtension); name != "" {
+		return name
+	}
+
+	return util.TerraformIdentifier(strings.Trim(titleSeparatorRegex.ReplaceAllString(spec.Info.Title, "_"), "_"))
+}
+
+// addSecuritySchemeProperties adds the provider attributes required to authenticate with a security scheme. Unsupported
+// security schemes, such as oauth2, and attributes that already exist are skipped.
+func addSecuritySchemeProperties(properties *orderedmap.Map[string, *base.SchemaProxy], name string, scheme *high.SecurityScheme) {
+	if scheme == nil {
+		return
+	}
+
+	addProperty := func(propName, description string, sensitive bool) {
+		if _, ok := properties.Get(propName); ok {
+			return
+		}
+
+		s := &base.Schema{
+			Type:        []string{util.OAS_type_string},
+			Description: description,
+		}
+		if sensitive {
+			s.Format = util.OAS_format_password
+		}
+
+		properties.Set(propName, base.CreateSchemaProxy(s))
+	}
+
+	switch strings.ToLower(scheme.Type) {
+	case "apikey":
+		description := scheme.Description
+		if description == "" {
+			description = fmt.Sprintf("API key sent in the '%s' %s", scheme.Name, scheme.In)
+		}
+		addProperty(util.TerraformIdentifier(name), description, true)
+	case "http":
+		switch strings.ToLower(scheme.Scheme) {
+		case "bearer":
+			addProperty("token", "Bearer token used to authenticate with the API", true)
+		case "basic":
+			addProperty("username", "Username used to authenticate with the API", false)
+			addProperty("password", "Password used to authenticate with the API", true)
+		}
+	}
+}
+
+func extensionString(extensions *orderedmap.Map[string, *yaml.Node], key string) string {
+	if extensions == nil {
+		return ""
+	}
+
+	node, ok := extensions.Get(key)
+	if !ok || node == nil {
+		return ""
+	}
+
+	return node.Value
+}
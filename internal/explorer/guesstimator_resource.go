@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// This regex matches the verb prefix of a create operationId, which is removed when using the operationId as a naming hint
+//   - createPet = MATCH (create)
+//   - add_pet = MATCH (add_)
+//   - pet = NO MATCH
+var createOperationIdPrefixRegex = regexp.MustCompile(`^(?i:create|add|post|put|new|insert|upsert|register)[_-]?`)
+
+// This regex matches API path segments that don't describe a resource, such as API versions or generic nouns, in which case a
+// naming hint is preferred over the name derived from the path
+//   - v1 = MATCH
+//   - items = MATCH
+//   - users = NO MATCH
+var genericPathSegmentRegex = regexp.MustCompile(`^(?i:api|apis|v\d+(\.\d+)*|entities|entries|items|objects|records|resources)$`)
+
+// resourceGuess is the result of evaluating a group of operations as a resource candidate.
+type resourceGuess struct {
+	Create *guessedOperation
+	Read   *guessedOperation
+	Update *guessedOperation
+	Delete *guessedOperation
+
+	// Accepted is true if the group of operations meets all requirements of a resource.
+	Accepted bool
+
+	// Reasons describes which pattern was used if the candidate was accepted, or each requirement that was not met if rejected.
+	Reasons []string
+}
+
+// guessedOperation is an operation found for a resource candidate, along with its location in the OpenAPI spec.
+type guessedOperation struct {
+	Path   string
+	Method string
+	Op     *high.Operation
+
+	// CommonParameters are the parameters defined on the path item, which apply to all operations on the path.
+	CommonParameters []*high.Parameter
+}
+
+func (o *guessedOperation) operation() *high.Operation {
+	if o == nil {
+		return nil
+	}
+
+	return o.Op
+}
+
+// guessResource evaluates the group of operations as a resource, in the following order:
+//   - Singleton: No identity operations, a GET and a PUT or PATCH collection operation, and no POST collection operation
+//   - Collection: A POST collection operation (or POST identity operation), GET identity operation, and DELETE identity operation
+//   - PUT-to-create: A PUT identity operation, GET identity operation, and DELETE identity operation
+//
+// For collection and PUT-to-create resources, the update operation is the PUT identity operation, falling back to PATCH.
+func (r resourceOperations) guessResource() resourceGuess {
+	// Singleton resources, i.e. /org/{org_id}/settings
+	if len(r.IdentityOps) == 0 && r.CollectionOps["post"] == nil && r.CollectionOps["get"] != nil {
+		upsert := r.collectionOp("put", "patch")
+		if upsert != nil {
+			return resourceGuess{
+				Create:   upsert,
+				Read:     r.collectionOp("get"),
+				Update:   upsert,
+				Delete:   r.collectionOp("delete"),
+				Accepted: true,
+				Reasons: []string{
+					fmt.Sprintf("singleton resource, created and updated with %s on '%s'", upsert.Method, upsert.Path),
+				},
+			}
+		}
+	}
+
+	guess := resourceGuess{
+		Read:   r.identityOp("get"),
+		Update: r.identityOp("put", "patch"),
+		Delete: r.identityOp("delete"),
+	}
+
+	var acceptedReasons, rejectedReasons []string
+
+	switch {
+	case r.CollectionOps["post"] != nil:
+		guess.Create = r.collectionOp("post")
+		acceptedReasons = append(acceptedReasons, fmt.Sprintf("created with POST on collection path '%s'", guess.Create.Path))
+	case r.IdentityOps["post"] != nil:
+		guess.Create = r.identityOp("post")
+		acceptedReasons = append(acceptedReasons, fmt.Sprintf("created with POST on identity path '%s'", guess.Create.Path))
+	case r.IdentityOps["put"] != nil:
+		guess.Create = r.identityOp("put")
+		acceptedReasons = append(acceptedReasons, fmt.Sprintf("created with PUT on identity path '%s' (PUT-to-create)", guess.Create.Path))
+	default:
+		rejectedReasons = append(rejectedReasons, fmt.Sprintf(
+			"no create operation, expected POST on %s or PUT on %s",
+			describePath(r.CollectionPath, "collection"),
+			describePath(r.IdentityPath, "identity"),
+		))
+	}
+
+	if guess.Read == nil {
+		rejectedReasons = append(rejectedReasons, fmt.Sprintf("no read operation, expected GET on %s", describePath(r.IdentityPath, "identity")))
+	}
+
+	if guess.Delete == nil {
+		rejectedReasons = append(rejectedReasons, fmt.Sprintf("no delete operation, expected DELETE on %s", describePath(r.IdentityPath, "identity")))
+	}
+
+	if len(r.IdentityOps) == 0 && r.CollectionPath != "" && r.CollectionOps["post"] == nil {
+		rejectedReasons = append(rejectedReasons, fmt.Sprintf("not a singleton resource, expected GET and PUT or PATCH on '%s'", r.CollectionPath))
+	}
+
+	if len(rejectedReasons) > 0 {
+		guess.Reasons = rejectedReasons
+		return guess
+	}
+
+	if guess.Update != nil && guess.Update.Method == "PATCH" {
+		acceptedReasons = append(acceptedReasons, fmt.Sprintf("updated with PATCH on '%s'", guess.Update.Path))
+	}
+
+	guess.Accepted = true
+	guess.Reasons = acceptedReasons
+
+	return guess
+}
+
+// nameHints returns possible resource names based on the operationId of the create operation and the tags of the create and
+// read operations, converted to Terraform identifiers.
+func (g resourceGuess) nameHints() []string {
+	var hints []string
+
+	addHint := func(hint string) {
+		hint = util.TerraformIdentifier(hint)
+		if hint != "" && !slices.Contains(hints, hint) {
+			hints = append(hints, hint)
+		}
+	}
+
+	if op := g.Create.operation(); op != nil && op.OperationId != "" {
+		addHint(createOperationIdPrefixRegex.ReplaceAllString(op.OperationId, ""))
+	}
+
+	for _, guessedOp := range []*guessedOperation{g.Create, g.Read} {
+		if op := guessedOp.operation(); op != nil {
+			for _, tag := range op.Tags {
+				addHint(tag)
+			}
+		}
+	}
+
+	return hints
+}
+
+// resourceName returns the name derived from the API path, unless no name could be derived or the path ends with a generic segment
+// (i.e. `/api/v1/items/{id}`), in which case the first naming hint is used. The name derived from the path is returned if there
+// are no naming hints.
+func (r resourceOperations) resourceName(pathName string, hints []string) string {
+	if len(hints) == 0 {
+		return pathName
+	}
+
+	if pathName == "" || isGenericPath(r.IdentityPath) || isGenericPath(r.CollectionPath) {
+		return hints[0]
+	}
+
+	return pathName
+}
+
+// resourceNames returns the resource name of each group of operations, keyed by the name derived from the API path. A naming hint
+// is only used if it's unique, otherwise the name derived from the API path is used instead. This happens when two groups have
+// the same naming hint (i.e. two generic paths with the same tag), or the naming hint matches the API path name of another group.
+// A group is skipped if no unique name can be found.
+func resourceNames(groups map[string]resourceOperations) map[string]string {
+	names := make(map[string]string, len(groups))
+	for pathName, group := range groups {
+		names[pathName] = group.resourceName(pathName, group.guessResource().nameHints())
+	}
+
+	// Falling back to the API path name can cause a new collision with another naming hint, so this is repeated until all names
+	// are unique. API path names are always unique, so each pass only ever reduces the number of naming hints in use.
+	for {
+		counts := map[string]int{}
+		for _, name := range names {
+			counts[name]++
+		}
+
+		collision := false
+		for pathName, name := range names {
+			if name != pathName && counts[name] > 1 {
+				names[pathName] = pathName
+				collision = true
+			}
+		}
+
+		if !collision {
+			break
+		}
+	}
+
+	for pathName, name := range names {
+		if name == "" {
+			delete(names, pathName)
+		}
+	}
+
+	return names
+}
+
+// isGenericPath checks if the last segment of an API path, excluding path parameters, matches genericPathSegmentRegex.
+func isGenericPath(urlPath string) bool {
+	segments := strings.FieldsFunc(urlPath, func(r rune) bool { return r == '/' })
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		if pathParameterRegex.MatchString(segments[i]) {
+			continue
+		}
+
+		return genericPathSegmentRegex.MatchString(segments[i])
+	}
+
+	return false
+}
+
+// identityOp returns the first identity operation found for the given methods.
+func (r resourceOperations) identityOp(methods ...string) *guessedOperation {
+	return findGuessedOperation(r.IdentityOps, r.IdentityPath, r.IdentityParameters, methods)
+}
+
+// collectionOp returns the first collection operation found for the given methods.
+func (r resourceOperations) collectionOp(methods ...string) *guessedOperation {
+	return findGuessedOperation(r.CollectionOps, r.CollectionPath, r.CollectionParameters, methods)
+}
+
+func findGuessedOperation(ops map[string]*high.Operation, path string, params []*high.Parameter, methods []string) *guessedOperation {
+	for _, method := range methods {
+		if op := ops[method]; op != nil {
+			return &guessedOperation{
+				Path:             path,
+				Method:           strings.ToUpper(method),
+				Op:               op,
+				CommonParameters: params,
+			}
+		}
+	}
+
+	return nil
+}
+
+func describePath(path, pathType string) string {
+	if path == "" {
+		return fmt.Sprintf("%s path (not found)", pathType)
+	}
+
+	return fmt.Sprintf("'%s'", path)
+}
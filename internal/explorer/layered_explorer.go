@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import "errors"
+
+var _ Explorer = layeredExplorer{}
+
+type layeredExplorer struct {
+	explorers []Explorer
+}
+
+// NewLayeredExplorer combines the results of multiple explorers, in priority order. A resource or data source found by an explorer
+// is not replaced by a resource or data source with the same name from a lower priority explorer. The provider is taken from the
+// first explorer that returns a provider name.
+//
+// This is used to layer a generator config on top of the vendor extensions in an OpenAPI spec, i.e.
+// NewLayeredExplorer(NewConfigExplorer(spec, cfg), NewExtensionExplorer(spec))
+func NewLayeredExplorer(explorers ...Explorer) Explorer {
+	return layeredExplorer{
+		explorers: explorers,
+	}
+}
+
+func (e layeredExplorer) FindProvider() (Provider, error) {
+	for _, explorer := range e.explorers {
+		provider, err := explorer.FindProvider()
+		if err != nil {
+			return Provider{}, err
+		}
+
+		if provider.Name != "" {
+			return provider, nil
+		}
+	}
+
+	return Provider{}, nil
+}
+
+func (e layeredExplorer) FindResources() (map[string]Resource, error) {
+	resources := map[string]Resource{}
+	var errResult error
+
+	for _, explorer := range e.explorers {
+		found, err := explorer.FindResources()
+		errResult = errors.Join(errResult, err)
+
+		for name, resource := range found {
+			if _, ok := resources[name]; !ok {
+				resources[name] = resource
+			}
+		}
+	}
+
+	return resources, errResult
+}
+
+func (e layeredExplorer) FindDataSources() (map[string]DataSource, error) {
+	dataSources := map[string]DataSource{}
+	var errResult error
+
+	for _, explorer := range e.explorers {
+		found, err := explorer.FindDataSources()
+		errResult = errors.Join(errResult, err)
+
+		for name, dataSource := range found {
+			if _, ok := dataSources[name]; !ok {
+				dataSources[name] = dataSource
+			}
+		}
+	}
+
+	return dataSources, errResult
+}
@@ -23,8 +23,11 @@ func (a *ResourceBoolAttribute) GetName() string {
 
 func (a *ResourceBoolAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	boolAttribute, ok := mergeAttribute.(*ResourceBoolAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = boolAttribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceBoolAttribute) Merge(mergeAttribute ResourceAttribute) (Resourc
 }
 
 func (a *ResourceBoolAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceBoolAttribute) GetName() string {
 
 func (a *DataSourceBoolAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	boolAttribute, ok := mergeAttribute.(*DataSourceBoolAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = boolAttribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceBoolAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceBoolAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
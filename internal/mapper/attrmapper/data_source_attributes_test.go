@@ -354,6 +354,116 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"type overrides": {
+			overrides: map[string]explorer.Override{
+				"object_attribute": {
+					Type: "string",
+				},
+				"object_attribute_two.list_nested": {
+					Type:        "set",
+					Description: "new description",
+				},
+			},
+			attributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "object_attribute",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceBoolAttribute{
+							Name: "bool_attribute",
+							BoolAttribute: datasource.BoolAttribute{
+								ComputedOptionalRequired: schema.Optional,
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("object description"),
+					},
+				},
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "object_attribute_two",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceListNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.DataSourceNestedAttributeObject{
+								Attributes: attrmapper.DataSourceAttributes{
+									&attrmapper.DataSourceStringAttribute{
+										Name: "string_attribute",
+										StringAttribute: datasource.StringAttribute{
+											ComputedOptionalRequired: schema.Optional,
+										},
+									},
+								},
+							},
+							ListNestedAttribute: datasource.ListNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Description:              pointer("old description"),
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "object_attribute",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("object description"),
+					},
+				},
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "object_attribute_two",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceSetNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.DataSourceNestedAttributeObject{
+								Attributes: attrmapper.DataSourceAttributes{
+									&attrmapper.DataSourceStringAttribute{
+										Name: "string_attribute",
+										StringAttribute: datasource.StringAttribute{
+											ComputedOptionalRequired: schema.Optional,
+										},
+									},
+								},
+							},
+							SetNestedAttribute: datasource.SetNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Description:              pointer("new description"),
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+		"invalid type override keeps attribute": {
+			overrides: map[string]explorer.Override{
+				"bool_attribute": {
+					Type: "set",
+				},
+			},
+			attributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
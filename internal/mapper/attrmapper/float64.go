@@ -23,8 +23,11 @@ func (a *ResourceFloat64Attribute) GetName() string {
 
 func (a *ResourceFloat64Attribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	float64Attribute, ok := mergeAttribute.(*ResourceFloat64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = float64Attribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceFloat64Attribute) Merge(mergeAttribute ResourceAttribute) (Reso
 }
 
 func (a *ResourceFloat64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceFloat64Attribute) GetName() string {
 
 func (a *DataSourceFloat64Attribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	float64Attribute, ok := mergeAttribute.(*DataSourceFloat64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = float64Attribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceFloat64Attribute) Merge(mergeAttribute DataSourceAttribute) (
 }
 
 func (a *DataSourceFloat64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
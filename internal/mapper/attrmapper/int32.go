@@ -23,8 +23,11 @@ func (a *ResourceInt32Attribute) GetName() string {
 
 func (a *ResourceInt32Attribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	Int32Attribute, ok := mergeAttribute.(*ResourceInt32Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = Int32Attribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceInt32Attribute) Merge(mergeAttribute ResourceAttribute) (Resour
 }
 
 func (a *ResourceInt32Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceInt32Attribute) GetName() string {
 
 func (a *DataSourceInt32Attribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	Int32Attribute, ok := mergeAttribute.(*DataSourceInt32Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = Int32Attribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceInt32Attribute) Merge(mergeAttribute DataSourceAttribute) (Da
 }
 
 func (a *DataSourceInt32Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
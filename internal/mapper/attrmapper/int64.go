@@ -23,8 +23,11 @@ func (a *ResourceInt64Attribute) GetName() string {
 
 func (a *ResourceInt64Attribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	int64Attribute, ok := mergeAttribute.(*ResourceInt64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = int64Attribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceInt64Attribute) Merge(mergeAttribute ResourceAttribute) (Resour
 }
 
 func (a *ResourceInt64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceInt64Attribute) GetName() string {
 
 func (a *DataSourceInt64Attribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	int64Attribute, ok := mergeAttribute.(*DataSourceInt64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = int64Attribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceInt64Attribute) Merge(mergeAttribute DataSourceAttribute) (Da
 }
 
 func (a *DataSourceInt64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -23,9 +23,8 @@ func (a *ResourceListAttribute) GetName() string {
 
 func (a *ResourceListAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	listAttribute, ok := mergeAttribute.(*ResourceListAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -37,7 +36,7 @@ func (a *ResourceListAttribute) Merge(mergeAttribute ResourceAttribute) (Resourc
 }
 
 func (a *ResourceListAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -61,9 +60,8 @@ func (a *DataSourceListAttribute) GetName() string {
 
 func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	listAttribute, ok := mergeAttribute.(*DataSourceListAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -75,7 +73,7 @@ func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceListAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -24,21 +24,22 @@ func (a *ResourceListNestedAttribute) GetName() string {
 
 func (a *ResourceListNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	listNestedAttribute, ok := mergeAttribute.(*ResourceListNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = listNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *ResourceListNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -50,6 +51,12 @@ func (a *ResourceListNestedAttribute) ApplyNestedOverride(path []string, overrid
 	return a, err
 }
 
+func (a *ResourceListNestedAttribute) ReplaceNestedAttribute(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *ResourceListNestedAttribute) ToSpec() resource.Attribute {
 	a.ListNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -74,21 +81,22 @@ func (a *DataSourceListNestedAttribute) GetName() string {
 
 func (a *DataSourceListNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	listNestedAttribute, ok := mergeAttribute.(*DataSourceListNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = listNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *DataSourceListNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -100,6 +108,12 @@ func (a *DataSourceListNestedAttribute) ApplyNestedOverride(path []string, overr
 	return a, err
 }
 
+func (a *DataSourceListNestedAttribute) ReplaceNestedAttribute(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceListNestedAttribute) ToSpec() datasource.Attribute {
 	a.ListNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -514,6 +514,35 @@ func TestResourceListAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitive, and deprecation message": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use another attribute",
+			},
+			expectedAttribute: &attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use another attribute"),
+					Description:              pointer("old description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Sensitive: pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
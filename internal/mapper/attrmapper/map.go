@@ -23,9 +23,8 @@ func (a *ResourceMapAttribute) GetName() string {
 
 func (a *ResourceMapAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	mapAttribute, ok := mergeAttribute.(*ResourceMapAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -37,7 +36,7 @@ func (a *ResourceMapAttribute) Merge(mergeAttribute ResourceAttribute) (Resource
 }
 
 func (a *ResourceMapAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -61,9 +60,8 @@ func (a *DataSourceMapAttribute) GetName() string {
 
 func (a *DataSourceMapAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	mapAttribute, ok := mergeAttribute.(*DataSourceMapAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -75,7 +73,7 @@ func (a *DataSourceMapAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceMapAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -24,21 +24,22 @@ func (a *ResourceMapNestedAttribute) GetName() string {
 
 func (a *ResourceMapNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	mapNestedAttribute, ok := mergeAttribute.(*ResourceMapNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = mapNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *ResourceMapNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -50,6 +51,12 @@ func (a *ResourceMapNestedAttribute) ApplyNestedOverride(path []string, override
 	return a, err
 }
 
+func (a *ResourceMapNestedAttribute) ReplaceNestedAttribute(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *ResourceMapNestedAttribute) ToSpec() resource.Attribute {
 	a.MapNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -74,21 +81,22 @@ func (a *DataSourceMapNestedAttribute) GetName() string {
 
 func (a *DataSourceMapNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	mapNestedAttribute, ok := mergeAttribute.(*DataSourceMapNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = mapNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *DataSourceMapNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -100,6 +108,12 @@ func (a *DataSourceMapNestedAttribute) ApplyNestedOverride(path []string, overri
 	return a, err
 }
 
+func (a *DataSourceMapNestedAttribute) ReplaceNestedAttribute(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceMapNestedAttribute) ToSpec() datasource.Attribute {
 	a.MapNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
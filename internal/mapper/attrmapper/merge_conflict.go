@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// MergeConflictError is returned when two attributes with the same name, but different types, are merged. The
+// target attribute is kept when a conflict occurs, the attribute that could not be merged is available in
+// MergeAttribute, which can be used to replace the target attribute with ResourceAttributes.ReplaceAttribute or
+// DataSourceAttributes.ReplaceAttribute.
+type MergeConflictError struct {
+	// Path is the attribute names from the top-level attribute to the conflicting attribute.
+	Path []string
+
+	// TargetType is the type of the attribute that was kept, i.e. "string".
+	TargetType string
+
+	// MergeType is the type of the attribute that could not be merged, i.e. "int64".
+	MergeType string
+
+	// MergeAttribute is the attribute that could not be merged, either a ResourceAttribute or a DataSourceAttribute.
+	MergeAttribute any
+}
+
+func newMergeConflictError(targetAttribute, mergeAttribute interface{ GetName() string }) *MergeConflictError {
+	return &MergeConflictError{
+		Path:           []string{targetAttribute.GetName()},
+		TargetType:     attributeTypeName(targetAttribute),
+		MergeType:      attributeTypeName(mergeAttribute),
+		MergeAttribute: mergeAttribute,
+	}
+}
+
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("attribute '%s' has conflicting types: %s and %s", e.AttributePath(), e.TargetType, e.MergeType)
+}
+
+// AttributePath returns the path of the conflicting attribute, joined with a '.'
+func (e *MergeConflictError) AttributePath() string {
+	return strings.Join(e.Path, ".")
+}
+
+// MergeConflicts returns all merge conflict errors contained in err, which can be a single error or multiple joined errors.
+func MergeConflicts(err error) []*MergeConflictError {
+	var result []*MergeConflictError
+
+	for _, e := range util.FlattenErrors(err) {
+		var conflictErr *MergeConflictError
+		if errors.As(e, &conflictErr) {
+			result = append(result, conflictErr)
+		}
+	}
+
+	return result
+}
+
+// nestMergeErrors prefixes the path of all merge conflict errors in err with the name of the parent attribute.
+func nestMergeErrors(parentName string, err error) error {
+	var errResult error
+
+	for _, e := range util.FlattenErrors(err) {
+		var conflictErr *MergeConflictError
+		if errors.As(e, &conflictErr) {
+			nestedErr := *conflictErr
+			nestedErr.Path = append([]string{parentName}, conflictErr.Path...)
+
+			errResult = errors.Join(errResult, &nestedErr)
+			continue
+		}
+
+		errResult = errors.Join(errResult, e)
+	}
+
+	return errResult
+}
+
+// attributeTypeName returns a human-readable type name of a resource or data source attribute, used in merge conflict errors.
+func attributeTypeName(attribute any) string {
+	switch attribute.(type) {
+	case *ResourceBoolAttribute, *DataSourceBoolAttribute:
+		return "bool"
+	case *ResourceFloat64Attribute, *DataSourceFloat64Attribute:
+		return "float64"
+	case *ResourceInt32Attribute, *DataSourceInt32Attribute:
+		return "int32"
+	case *ResourceInt64Attribute, *DataSourceInt64Attribute:
+		return "int64"
+	case *ResourceListAttribute, *DataSourceListAttribute:
+		return "list"
+	case *ResourceListNestedAttribute, *DataSourceListNestedAttribute:
+		return "list_nested"
+	case *ResourceMapAttribute, *DataSourceMapAttribute:
+		return "map"
+	case *ResourceMapNestedAttribute, *DataSourceMapNestedAttribute:
+		return "map_nested"
+	case *ResourceNumberAttribute, *DataSourceNumberAttribute:
+		return "number"
+	case *ResourceSetAttribute, *DataSourceSetAttribute:
+		return "set"
+	case *ResourceSetNestedAttribute, *DataSourceSetNestedAttribute:
+		return "set_nested"
+	case *ResourceSingleNestedAttribute, *DataSourceSingleNestedAttribute:
+		return "single_nested"
+	case *ResourceStringAttribute, *DataSourceStringAttribute:
+		return "string"
+	default:
+		return fmt.Sprintf("%T", attribute)
+	}
+}
+
+// resourceComputability returns a pointer to the computability of a resource attribute, or nil if the attribute type is unknown.
+func resourceComputability(attribute ResourceAttribute) *schema.ComputedOptionalRequired {
+	switch a := attribute.(type) {
+	case *ResourceBoolAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceFloat64Attribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceInt32Attribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceInt64Attribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceListAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceListNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceMapAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceMapNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceNumberAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceSetAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceSetNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceSingleNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *ResourceStringAttribute:
+		return &a.ComputedOptionalRequired
+	default:
+		return nil
+	}
+}
+
+// dataSourceComputability returns a pointer to the computability of a data source attribute, or nil if the attribute type is unknown.
+func dataSourceComputability(attribute DataSourceAttribute) *schema.ComputedOptionalRequired {
+	switch a := attribute.(type) {
+	case *DataSourceBoolAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceFloat64Attribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceInt32Attribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceInt64Attribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceListAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceListNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceMapAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceMapNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceNumberAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceSetAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceSetNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceSingleNestedAttribute:
+		return &a.ComputedOptionalRequired
+	case *DataSourceStringAttribute:
+		return &a.ComputedOptionalRequired
+	default:
+		return nil
+	}
+}
@@ -23,8 +23,11 @@ func (a *ResourceNumberAttribute) GetName() string {
 
 func (a *ResourceNumberAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	numberAttribute, ok := mergeAttribute.(*ResourceNumberAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = numberAttribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceNumberAttribute) Merge(mergeAttribute ResourceAttribute) (Resou
 }
 
 func (a *ResourceNumberAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceNumberAttribute) GetName() string {
 
 func (a *DataSourceNumberAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	numberAttribute, ok := mergeAttribute.(*DataSourceNumberAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = numberAttribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceNumberAttribute) Merge(mergeAttribute DataSourceAttribute) (D
 }
 
 func (a *DataSourceNumberAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
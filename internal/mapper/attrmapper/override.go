@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+)
+
+// ErrUnmatchedOverride is returned when an override path doesn't match any attribute.
+var ErrUnmatchedOverride = errors.New("no matching attribute found")
+
+const (
+	overrideTypeString = "string"
+	overrideTypeList   = "list"
+	overrideTypeSet    = "set"
+)
+
+// overrideFields are the fields shared by every resource and data source attribute type, which are preserved when an attribute
+// is overridden to a different type.
+type overrideFields struct {
+	ComputedOptionalRequired schema.ComputedOptionalRequired
+	DeprecationMessage       *string
+	Description              *string
+	Sensitive                *bool
+}
+
+// applyOverrideFields applies the computability, deprecation message, description, and sensitivity of an override to the fields
+// shared by every resource and data source attribute type. Empty override fields leave the attribute unchanged.
+func applyOverrideFields(override explorer.Override, computability *schema.ComputedOptionalRequired, deprecationMessage **string, description **string, sensitive **bool) {
+	if override.Description != "" {
+		*description = &override.Description
+	}
+	if override.ComputedOptionalRequired != "" {
+		*computability = override.ComputedOptionalRequired
+	}
+	if override.Sensitive != nil {
+		*sensitive = override.Sensitive
+	}
+	if override.DeprecationMessage != "" {
+		*deprecationMessage = &override.DeprecationMessage
+	}
+}
+
+// resourceOverrideFields returns the shared fields of a resource attribute, if the attribute type can be overridden to a string.
+func resourceOverrideFields(attribute ResourceAttribute) (overrideFields, bool) {
+	switch a := attribute.(type) {
+	case *ResourceBoolAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceFloat64Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceInt32Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceInt64Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceListAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceListNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceMapAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceMapNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceNumberAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceSetAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceSetNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *ResourceSingleNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	default:
+		return overrideFields{}, false
+	}
+}
+
+// dataSourceOverrideFields returns the shared fields of a data source attribute, if the attribute type can be overridden to a string.
+func dataSourceOverrideFields(attribute DataSourceAttribute) (overrideFields, bool) {
+	switch a := attribute.(type) {
+	case *DataSourceBoolAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceFloat64Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceInt32Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceInt64Attribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceListAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceListNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceMapAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceMapNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceNumberAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceSetAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceSetNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	case *DataSourceSingleNestedAttribute:
+		return overrideFields{a.ComputedOptionalRequired, a.DeprecationMessage, a.Description, a.Sensitive}, true
+	default:
+		return overrideFields{}, false
+	}
+}
+
+// convertResourceAttribute forces a resource attribute to a different type, from an override. The computability, description,
+// deprecation message, and sensitivity of the attribute are preserved. Defaults and validators are removed, as they are specific
+// to the original type.
+//
+// Supported conversions:
+//   - Any attribute to "string", i.e. an object that should be a string containing JSON
+//   - List or list nested attributes to "set"
+//   - Set or set nested attributes to "list"
+func convertResourceAttribute(attribute ResourceAttribute, attributeType string) (ResourceAttribute, error) {
+	switch attributeType {
+	case "":
+		return attribute, nil
+	case overrideTypeString:
+		if _, ok := attribute.(*ResourceStringAttribute); ok {
+			return attribute, nil
+		}
+
+		fields, ok := resourceOverrideFields(attribute)
+		if ok {
+			return &ResourceStringAttribute{
+				Name: attribute.GetName(),
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: fields.ComputedOptionalRequired,
+					DeprecationMessage:       fields.DeprecationMessage,
+					Description:              fields.Description,
+					Sensitive:                fields.Sensitive,
+				},
+			}, nil
+		}
+	case overrideTypeSet:
+		switch a := attribute.(type) {
+		case *ResourceSetAttribute, *ResourceSetNestedAttribute:
+			return attribute, nil
+		case *ResourceListAttribute:
+			return &ResourceSetAttribute{
+				Name: a.Name,
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					ElementType:              a.ElementType,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		case *ResourceListNestedAttribute:
+			return &ResourceSetNestedAttribute{
+				Name:         a.Name,
+				NestedObject: a.NestedObject,
+				SetNestedAttribute: resource.SetNestedAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		}
+	case overrideTypeList:
+		switch a := attribute.(type) {
+		case *ResourceListAttribute, *ResourceListNestedAttribute:
+			return attribute, nil
+		case *ResourceSetAttribute:
+			return &ResourceListAttribute{
+				Name: a.Name,
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					ElementType:              a.ElementType,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		case *ResourceSetNestedAttribute:
+			return &ResourceListNestedAttribute{
+				Name:         a.Name,
+				NestedObject: a.NestedObject,
+				ListNestedAttribute: resource.ListNestedAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		}
+	}
+
+	return attribute, fmt.Errorf("unable to override attribute '%s' of type %s to type %s", attribute.GetName(), attributeTypeName(attribute), attributeType)
+}
+
+// convertDataSourceAttribute forces a data source attribute to a different type, from an override. See convertResourceAttribute
+// for the supported conversions.
+func convertDataSourceAttribute(attribute DataSourceAttribute, attributeType string) (DataSourceAttribute, error) {
+	switch attributeType {
+	case "":
+		return attribute, nil
+	case overrideTypeString:
+		if _, ok := attribute.(*DataSourceStringAttribute); ok {
+			return attribute, nil
+		}
+
+		fields, ok := dataSourceOverrideFields(attribute)
+		if ok {
+			return &DataSourceStringAttribute{
+				Name: attribute.GetName(),
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: fields.ComputedOptionalRequired,
+					DeprecationMessage:       fields.DeprecationMessage,
+					Description:              fields.Description,
+					Sensitive:                fields.Sensitive,
+				},
+			}, nil
+		}
+	case overrideTypeSet:
+		switch a := attribute.(type) {
+		case *DataSourceSetAttribute, *DataSourceSetNestedAttribute:
+			return attribute, nil
+		case *DataSourceListAttribute:
+			return &DataSourceSetAttribute{
+				Name: a.Name,
+				SetAttribute: datasource.SetAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					ElementType:              a.ElementType,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		case *DataSourceListNestedAttribute:
+			return &DataSourceSetNestedAttribute{
+				Name:         a.Name,
+				NestedObject: a.NestedObject,
+				SetNestedAttribute: datasource.SetNestedAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		}
+	case overrideTypeList:
+		switch a := attribute.(type) {
+		case *DataSourceListAttribute, *DataSourceListNestedAttribute:
+			return attribute, nil
+		case *DataSourceSetAttribute:
+			return &DataSourceListAttribute{
+				Name: a.Name,
+				ListAttribute: datasource.ListAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					ElementType:              a.ElementType,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		case *DataSourceSetNestedAttribute:
+			return &DataSourceListNestedAttribute{
+				Name:         a.Name,
+				NestedObject: a.NestedObject,
+				ListNestedAttribute: datasource.ListNestedAttribute{
+					ComputedOptionalRequired: a.ComputedOptionalRequired,
+					DeprecationMessage:       a.DeprecationMessage,
+					Description:              a.Description,
+					Sensitive:                a.Sensitive,
+				},
+			}, nil
+		}
+	}
+
+	return attribute, fmt.Errorf("unable to override attribute '%s' of type %s to type %s", attribute.GetName(), attributeTypeName(attribute), attributeType)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+)
+
+// ApplyRequiresReplace adds a RequiresReplace plan modifier to every attribute that exists in the create attributes but not in
+// the update attributes, as changing these attributes can't be done in-place. Nested attributes that exist in both are traversed
+// to find any nested attributes that only exist in the create attributes. Computed-only attributes are skipped.
+func (attributes ResourceAttributes) ApplyRequiresReplace(createAttributes ResourceAttributes, updateAttributes ResourceAttributes) {
+	for _, attribute := range attributes {
+		createAttribute := createAttributes.find(attribute.GetName())
+		if createAttribute == nil {
+			continue
+		}
+
+		computability := resourceComputability(attribute)
+		if computability == nil || *computability == schema.Computed {
+			continue
+		}
+
+		updateAttribute := updateAttributes.find(attribute.GetName())
+		if updateAttribute == nil {
+			addResourcePlanModifier(attribute, frameworkplanmodifiers.RequiresReplace)
+			continue
+		}
+
+		nestedAttributes, ok := nestedResourceAttributes(attribute)
+		if !ok {
+			continue
+		}
+
+		nestedCreateAttributes, _ := nestedResourceAttributes(createAttribute)
+		nestedUpdateAttributes, _ := nestedResourceAttributes(updateAttribute)
+
+		nestedAttributes.ApplyRequiresReplace(nestedCreateAttributes, nestedUpdateAttributes)
+	}
+}
+
+// ApplyUseStateForUnknown adds a UseStateForUnknown plan modifier to every top-level computed-only attribute, i.e. "id" or
+// "created_at", which prevents these attributes from being shown as unknown in every plan after the resource is created.
+func (attributes ResourceAttributes) ApplyUseStateForUnknown() {
+	for _, attribute := range attributes {
+		computability := resourceComputability(attribute)
+		if computability == nil || *computability != schema.Computed {
+			continue
+		}
+
+		addResourcePlanModifier(attribute, frameworkplanmodifiers.UseStateForUnknown)
+	}
+}
+
+func (attributes ResourceAttributes) find(name string) ResourceAttribute {
+	for _, attribute := range attributes {
+		if attribute.GetName() == name {
+			return attribute
+		}
+	}
+
+	return nil
+}
+
+// nestedResourceAttributes returns the nested attributes of a resource attribute, if the attribute is a nested type.
+func nestedResourceAttributes(attribute ResourceAttribute) (ResourceAttributes, bool) {
+	switch a := attribute.(type) {
+	case *ResourceListNestedAttribute:
+		return a.NestedObject.Attributes, true
+	case *ResourceMapNestedAttribute:
+		return a.NestedObject.Attributes, true
+	case *ResourceSetNestedAttribute:
+		return a.NestedObject.Attributes, true
+	case *ResourceSingleNestedAttribute:
+		return a.Attributes, true
+	default:
+		return nil, false
+	}
+}
+
+// addResourcePlanModifier adds a plan modifier to a resource attribute, using the plan modifier package of the attribute type.
+func addResourcePlanModifier(attribute ResourceAttribute, planModifier func(string) *schema.CustomPlanModifier) {
+	switch a := attribute.(type) {
+	case *ResourceBoolAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.BoolPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.BoolPlanModifierPackage),
+		})
+	case *ResourceFloat64Attribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.Float64PlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.Float64PlanModifierPackage),
+		})
+	case *ResourceInt32Attribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.Int32PlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.Int32PlanModifierPackage),
+		})
+	case *ResourceInt64Attribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.Int64PlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.Int64PlanModifierPackage),
+		})
+	case *ResourceListAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.ListPlanModifierPackage),
+		})
+	case *ResourceListNestedAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.ListPlanModifierPackage),
+		})
+	case *ResourceMapAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.MapPlanModifierPackage),
+		})
+	case *ResourceMapNestedAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.MapPlanModifierPackage),
+		})
+	case *ResourceNumberAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.NumberPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.NumberPlanModifierPackage),
+		})
+	case *ResourceSetAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.SetPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.SetPlanModifierPackage),
+		})
+	case *ResourceSetNestedAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.SetPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.SetPlanModifierPackage),
+		})
+	case *ResourceSingleNestedAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.ObjectPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.ObjectPlanModifierPackage),
+		})
+	case *ResourceStringAttribute:
+		a.PlanModifiers = append(a.PlanModifiers, schema.StringPlanModifier{
+			Custom: planModifier(frameworkplanmodifiers.StringPlanModifierPackage),
+		})
+	}
+}
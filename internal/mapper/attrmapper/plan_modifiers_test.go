@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+)
+
+func TestResourceAttributes_ApplyRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes         attrmapper.ResourceAttributes
+		createAttributes   attrmapper.ResourceAttributes
+		updateAttributes   attrmapper.ResourceAttributes
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"create only attributes": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "region",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			createAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "region",
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+				},
+			},
+			updateAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "region",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"nested create only attributes": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "encrypted",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Optional,
+							},
+						},
+						&attrmapper.ResourceInt64Attribute{
+							Name: "size",
+							Int64Attribute: resource.Int64Attribute{
+								ComputedOptionalRequired: schema.Optional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			createAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "encrypted",
+						},
+						&attrmapper.ResourceInt64Attribute{
+							Name: "size",
+						},
+					},
+				},
+			},
+			updateAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceInt64Attribute{
+							Name: "size",
+						},
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "encrypted",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								PlanModifiers: schema.BoolPlanModifiers{
+									{
+										Custom: &schema.CustomPlanModifier{
+											Imports: []code.Import{
+												{
+													Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+												},
+											},
+											SchemaDefinition: "boolplanmodifier.RequiresReplace()",
+										},
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceInt64Attribute{
+							Name: "size",
+							Int64Attribute: resource.Int64Attribute{
+								ComputedOptionalRequired: schema.Optional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.attributes.ApplyRequiresReplace(testCase.createAttributes, testCase.updateAttributes)
+
+			if diff := cmp.Diff(testCase.attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAttributes_ApplyUseStateForUnknown(t *testing.T) {
+	t.Parallel()
+
+	attributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceStringAttribute{
+			Name: "id",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+		&attrmapper.ResourceStringAttribute{
+			Name: "name",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+			},
+		},
+	}
+
+	attributes.ApplyUseStateForUnknown()
+
+	expectedAttributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceStringAttribute{
+			Name: "id",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: &schema.CustomPlanModifier{
+							Imports: []code.Import{
+								{
+									Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+								},
+							},
+							SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+						},
+					},
+				},
+			},
+		},
+		&attrmapper.ResourceStringAttribute{
+			Name: "name",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(attributes, expectedAttributes); diff != "" {
+		t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+	}
+}
@@ -5,9 +5,11 @@ package attrmapper
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
 )
 
@@ -20,6 +22,7 @@ type ResourceAttribute interface {
 
 type ResourceNestedAttribute interface {
 	ApplyNestedOverride([]string, explorer.Override) (ResourceAttribute, error)
+	ReplaceNestedAttribute([]string, ResourceAttribute) ResourceAttribute
 }
 
 type ResourceAttributes []ResourceAttribute
@@ -36,8 +39,7 @@ func (targetSlice ResourceAttributes) Merge(mergeSlices ...ResourceAttributes) (
 				if targetAttribute.GetName() == mergeAttribute.GetName() {
 					mergedAttribute, err := targetAttribute.Merge(mergeAttribute)
 					if err != nil {
-						// TODO: consider how best to surface this error
-						// Currently, if the merge fails we should just keep the original target attribute for now
+						// The original target attribute is kept, the merge conflict is returned to the caller
 						errResult = errors.Join(errResult, err)
 					} else {
 						targetSlice[i] = mergedAttribute
@@ -58,6 +60,67 @@ func (targetSlice ResourceAttributes) Merge(mergeSlices ...ResourceAttributes) (
 	return targetSlice, errResult
 }
 
+// Clone returns a copy of the attributes, including all nested attributes, so the copy isn't modified when the original attributes
+// are merged or overridden. The fields of each attribute type, i.e. validators, are shallow copied.
+func (attributes ResourceAttributes) Clone() ResourceAttributes {
+	result := make(ResourceAttributes, 0, len(attributes))
+	for _, attribute := range attributes {
+		result = append(result, cloneResourceAttribute(attribute))
+	}
+
+	return result
+}
+
+func cloneResourceAttribute(attribute ResourceAttribute) ResourceAttribute {
+	switch a := attribute.(type) {
+	case *ResourceBoolAttribute:
+		clone := *a
+		return &clone
+	case *ResourceFloat64Attribute:
+		clone := *a
+		return &clone
+	case *ResourceInt32Attribute:
+		clone := *a
+		return &clone
+	case *ResourceInt64Attribute:
+		clone := *a
+		return &clone
+	case *ResourceListAttribute:
+		clone := *a
+		return &clone
+	case *ResourceListNestedAttribute:
+		clone := *a
+		clone.NestedObject.Attributes = a.NestedObject.Attributes.Clone()
+		return &clone
+	case *ResourceMapAttribute:
+		clone := *a
+		return &clone
+	case *ResourceMapNestedAttribute:
+		clone := *a
+		clone.NestedObject.Attributes = a.NestedObject.Attributes.Clone()
+		return &clone
+	case *ResourceNumberAttribute:
+		clone := *a
+		return &clone
+	case *ResourceSetAttribute:
+		clone := *a
+		return &clone
+	case *ResourceSetNestedAttribute:
+		clone := *a
+		clone.NestedObject.Attributes = a.NestedObject.Attributes.Clone()
+		return &clone
+	case *ResourceSingleNestedAttribute:
+		clone := *a
+		clone.Attributes = a.Attributes.Clone()
+		return &clone
+	case *ResourceStringAttribute:
+		clone := *a
+		return &clone
+	default:
+		return attribute
+	}
+}
+
 func (attributes ResourceAttributes) ToSpec() []resource.Attribute {
 	specAttributes := make([]resource.Attribute, 0, len(attributes))
 	for _, attribute := range attributes {
@@ -69,10 +132,12 @@ func (attributes ResourceAttributes) ToSpec() []resource.Attribute {
 
 func (attributes ResourceAttributes) ApplyOverrides(overrideMap map[string]explorer.Override) (ResourceAttributes, error) {
 	var errResult error
-	for key, override := range overrideMap {
+	for _, key := range util.SortedKeys(overrideMap) {
 		var err error
-		attributes, err = attributes.ApplyOverride(strings.Split(key, "."), override)
-		errResult = errors.Join(errResult, err)
+		attributes, err = attributes.ApplyOverride(strings.Split(key, "."), overrideMap[key])
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("override '%s' - %w", key, err))
+		}
 	}
 
 	return attributes, errResult
@@ -83,14 +148,16 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 	if len(path) == 0 {
 		return attributes, errResult
 	}
+	if util.IsAttributeLocationPattern(path) {
+		return attributes.applyOverridePattern(path, override)
+	}
 	for i, attribute := range attributes {
 		if attribute.GetName() == path[0] {
 
 			if len(path) > 1 {
 				nestedAttribute, ok := attribute.(ResourceNestedAttribute)
 				if !ok {
-					// TODO: error? there is a nested override for an attribute that is not a nested type
-					break
+					return attributes, fmt.Errorf("%w, '%s' is not a nested attribute", ErrUnmatchedOverride, attribute.GetName())
 				}
 
 				// The attribute we need to override is deeper nested, move up
@@ -106,12 +173,94 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 				overriddenAttribute, err := attribute.ApplyOverride(override)
 				errResult = errors.Join(errResult, err)
 
+				overriddenAttribute, err = convertResourceAttribute(overriddenAttribute, override.Type)
+				errResult = errors.Join(errResult, err)
+
 				attributes[i] = overriddenAttribute
 			}
 
-			break
+			return attributes, errResult
+		}
+	}
+
+	return attributes, fmt.Errorf("%w for '%s'", ErrUnmatchedOverride, path[0])
+}
+
+// applyOverridePattern applies the override to every attribute matching an attribute location with wildcards, i.e. `*.metadata.etag`
+// or `**.links`. An error is only returned for an unmatched override if no attribute matches.
+func (attributes ResourceAttributes) applyOverridePattern(path []string, override explorer.Override) (ResourceAttributes, error) {
+	var errResult error
+	matched := false
+
+	for i := range attributes {
+		for _, remaining := range util.MatchAttributeName(path, attributes[i].GetName()) {
+			if len(remaining) == 0 {
+				overriddenAttribute, err := attributes[i].ApplyOverride(override)
+				errResult = errors.Join(errResult, err)
+
+				overriddenAttribute, err = convertResourceAttribute(overriddenAttribute, override.Type)
+				errResult = errors.Join(errResult, err)
+
+				attributes[i] = overriddenAttribute
+				matched = true
+				continue
+			}
+
+			nestedAttribute, ok := attributes[i].(ResourceNestedAttribute)
+			if !ok {
+				continue
+			}
+
+			overriddenAttribute, err := nestedAttribute.ApplyNestedOverride(remaining, override)
+			attributes[i] = overriddenAttribute
+
+			// Nested attributes that don't match the rest of the pattern are skipped
+			if errors.Is(err, ErrUnmatchedOverride) {
+				continue
+			}
+
+			errResult = errors.Join(errResult, err)
+			matched = true
 		}
 	}
 
+	if !matched {
+		return attributes, fmt.Errorf("%w for '%s'", ErrUnmatchedOverride, strings.Join(path, "."))
+	}
+
 	return attributes, errResult
 }
+
+// ReplaceAttribute replaces the attribute at the given path with the replacement attribute, which is used to resolve merge
+// conflicts. The computability of the replaced attribute is preserved.
+func (attributes ResourceAttributes) ReplaceAttribute(path []string, replacement ResourceAttribute) ResourceAttributes {
+	if len(path) == 0 {
+		return attributes
+	}
+	for i, attribute := range attributes {
+		if attribute.GetName() == path[0] {
+
+			if len(path) > 1 {
+				nestedAttribute, ok := attribute.(ResourceNestedAttribute)
+				if !ok {
+					break
+				}
+
+				attributes[i] = nestedAttribute.ReplaceNestedAttribute(path[1:], replacement)
+
+			} else {
+				targetComputability := resourceComputability(attribute)
+				replacementComputability := resourceComputability(replacement)
+				if targetComputability != nil && replacementComputability != nil {
+					*replacementComputability = *targetComputability
+				}
+
+				attributes[i] = replacement
+			}
+
+			break
+		}
+	}
+
+	return attributes
+}
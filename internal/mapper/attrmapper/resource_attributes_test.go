@@ -4,6 +4,7 @@
 package attrmapper_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -354,6 +355,163 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"type overrides": {
+			overrides: map[string]explorer.Override{
+				"object_attribute": {
+					Type: "string",
+				},
+				"object_attribute_two.list_nested": {
+					Type:        "set",
+					Description: "new description",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "object_attribute",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool_attribute",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Optional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("object description"),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "object_attribute_two",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceStringAttribute{
+										Name: "string_attribute",
+										StringAttribute: resource.StringAttribute{
+											ComputedOptionalRequired: schema.Optional,
+										},
+									},
+								},
+							},
+							ListNestedAttribute: resource.ListNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Description:              pointer("old description"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "object_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("object description"),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "object_attribute_two",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSetNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceStringAttribute{
+										Name: "string_attribute",
+										StringAttribute: resource.StringAttribute{
+											ComputedOptionalRequired: schema.Optional,
+										},
+									},
+								},
+							},
+							SetNestedAttribute: resource.SetNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Description:              pointer("new description"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+		"string type overrides preserve shared fields": {
+			overrides: map[string]explorer.Override{
+				"int32_attribute": {
+					Type: "string",
+				},
+				"map_attribute": {
+					Type: "string",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int32_attribute",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.Required,
+						DeprecationMessage:       pointer("deprecated"),
+						Sensitive:                pointer(true),
+					},
+				},
+				&attrmapper.ResourceMapAttribute{
+					Name: "map_attribute",
+					MapAttribute: resource.MapAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("map description"),
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "int32_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						DeprecationMessage:       pointer("deprecated"),
+						Sensitive:                pointer(true),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "map_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("map description"),
+					},
+				},
+			},
+		},
+		"invalid type override keeps attribute": {
+			overrides: map[string]explorer.Override{
+				"bool_attribute": {
+					Type: "set",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -368,3 +526,277 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceAttributes_Merge_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		targetAttributes   attrmapper.ResourceAttributes
+		mergeAttributes    attrmapper.ResourceAttributes
+		expectedAttributes attrmapper.ResourceAttributes
+		expectedConflicts  []string
+	}{
+		"top-level conflict keeps target": {
+			targetAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "port",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			mergeAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "port",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "port",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedConflicts: []string{
+				"attribute 'port' has conflicting types: string and int64",
+			},
+		},
+		"nested conflict": {
+			targetAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "rules",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceBoolAttribute{
+										Name: "enabled",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			mergeAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "rules",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceStringAttribute{
+										Name: "enabled",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "config",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "rules",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceBoolAttribute{
+										Name: "enabled",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedConflicts: []string{
+				"attribute 'config.rules.enabled' has conflicting types: bool and string",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.targetAttributes.Merge(testCase.mergeAttributes)
+
+			if diff := cmp.Diff(got, testCase.expectedAttributes); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			var gotConflicts []string
+			for _, conflict := range attrmapper.MergeConflicts(err) {
+				gotConflicts = append(gotConflicts, conflict.Error())
+			}
+
+			if diff := cmp.Diff(gotConflicts, testCase.expectedConflicts); diff != "" {
+				t.Errorf("Unexpected conflicts (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAttributes_ReplaceAttribute(t *testing.T) {
+	t.Parallel()
+
+	attributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "config",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "port",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+	}
+
+	got := attributes.ReplaceAttribute([]string{"config", "port"}, &attrmapper.ResourceInt64Attribute{
+		Name: "port",
+		Int64Attribute: resource.Int64Attribute{
+			ComputedOptionalRequired: schema.Computed,
+			Description:              pointer("port description"),
+		},
+	})
+
+	expectedAttributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "config",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "port",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("port description"),
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expectedAttributes); diff != "" {
+		t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+	}
+}
+
+func TestResourceAttributes_ApplyOverrides_Unmatched(t *testing.T) {
+	t.Parallel()
+
+	attributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceStringAttribute{
+			Name: "string_attribute",
+		},
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "single_nested",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+				},
+			},
+		},
+	}
+
+	_, err := attributes.ApplyOverrides(map[string]explorer.Override{
+		"single_nested.bool_attribute":   {Description: "matched"},
+		"single_nested.renamed":          {Description: "not matched"},
+		"string_attribute.nested":        {Description: "not matched"},
+		"renamed_attribute":              {Description: "not matched"},
+		"string_attribute":               {Description: "matched"},
+		"single_nested.bool_attribute.x": {Description: "not matched"},
+	})
+
+	expectedErr := "override 'renamed_attribute' - no matching attribute found for 'renamed_attribute'\n" +
+		"override 'single_nested.bool_attribute.x' - no matching attribute found, 'bool_attribute' is not a nested attribute\n" +
+		"override 'single_nested.renamed' - no matching attribute found for 'renamed'\n" +
+		"override 'string_attribute.nested' - no matching attribute found, 'string_attribute' is not a nested attribute"
+
+	if err == nil {
+		t.Fatalf("expected error, got none")
+	}
+
+	if diff := cmp.Diff(err.Error(), expectedErr); diff != "" {
+		t.Errorf("Unexpected error (-got, +expected): %s", diff)
+	}
+
+	if !errors.Is(err, attrmapper.ErrUnmatchedOverride) {
+		t.Errorf("expected error to be ErrUnmatchedOverride, got: %s", err)
+	}
+}
+
+func TestResourceAttributes_ApplyOverrides_Wildcards(t *testing.T) {
+	t.Parallel()
+
+	metadata := func() attrmapper.ResourceAttribute {
+		return &attrmapper.ResourceSingleNestedAttribute{
+			Name: "metadata",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{Name: "etag"},
+				&attrmapper.ResourceStringAttribute{Name: "name"},
+			},
+		}
+	}
+
+	attributes := attrmapper.ResourceAttributes{
+		metadata(),
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "spec",
+			Attributes: attrmapper.ResourceAttributes{
+				metadata(),
+				&attrmapper.ResourceStringAttribute{Name: "etag"},
+			},
+		},
+	}
+
+	got, err := attributes.ApplyOverrides(map[string]explorer.Override{
+		"**.etag":         {Description: "etag"},
+		"*.metadata.na?e": {Description: "nested name"},
+		"**.missing":      {Description: "not matched"},
+	})
+
+	expectedErr := "override '**.missing' - no matching attribute found for '**.missing'"
+	if err == nil {
+		t.Fatalf("expected error, got none")
+	}
+
+	if diff := cmp.Diff(err.Error(), expectedErr); diff != "" {
+		t.Errorf("Unexpected error (-got, +expected): %s", diff)
+	}
+
+	expected := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "metadata",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{Name: "etag", StringAttribute: resource.StringAttribute{Description: pointer("etag")}},
+				&attrmapper.ResourceStringAttribute{Name: "name"},
+			},
+		},
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "spec",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "metadata",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{Name: "etag", StringAttribute: resource.StringAttribute{Description: pointer("etag")}},
+						&attrmapper.ResourceStringAttribute{Name: "name", StringAttribute: resource.StringAttribute{Description: pointer("nested name")}},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{Name: "etag", StringAttribute: resource.StringAttribute{Description: pointer("etag")}},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -23,9 +23,8 @@ func (a *ResourceSetAttribute) GetName() string {
 
 func (a *ResourceSetAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	setAttribute, ok := mergeAttribute.(*ResourceSetAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -37,7 +36,7 @@ func (a *ResourceSetAttribute) Merge(mergeAttribute ResourceAttribute) (Resource
 }
 
 func (a *ResourceSetAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -61,9 +60,8 @@ func (a *DataSourceSetAttribute) GetName() string {
 
 func (a *DataSourceSetAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	setAttribute, ok := mergeAttribute.(*DataSourceSetAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -75,7 +73,7 @@ func (a *DataSourceSetAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceSetAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -24,21 +24,22 @@ func (a *ResourceSetNestedAttribute) GetName() string {
 
 func (a *ResourceSetNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	setNestedAttribute, ok := mergeAttribute.(*ResourceSetNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = setNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *ResourceSetNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -50,6 +51,12 @@ func (a *ResourceSetNestedAttribute) ApplyNestedOverride(path []string, override
 	return a, err
 }
 
+func (a *ResourceSetNestedAttribute) ReplaceNestedAttribute(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *ResourceSetNestedAttribute) ToSpec() resource.Attribute {
 	a.SetNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -74,21 +81,22 @@ func (a *DataSourceSetNestedAttribute) GetName() string {
 
 func (a *DataSourceSetNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	setNestedAttribute, ok := mergeAttribute.(*DataSourceSetNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = setNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *DataSourceSetNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -100,6 +108,12 @@ func (a *DataSourceSetNestedAttribute) ApplyNestedOverride(path []string, overri
 	return a, err
 }
 
+func (a *DataSourceSetNestedAttribute) ReplaceNestedAttribute(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceSetNestedAttribute) ToSpec() datasource.Attribute {
 	a.SetNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
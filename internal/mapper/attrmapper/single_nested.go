@@ -24,21 +24,22 @@ func (a *ResourceSingleNestedAttribute) GetName() string {
 
 func (a *ResourceSingleNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	singleNestedAttribute, ok := mergeAttribute.(*ResourceSingleNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = singleNestedAttribute.Description
 	}
-	a.Attributes, _ = a.Attributes.Merge(singleNestedAttribute.Attributes)
 
-	return a, nil
+	var err error
+	a.Attributes, err = a.Attributes.Merge(singleNestedAttribute.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *ResourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -50,6 +51,12 @@ func (a *ResourceSingleNestedAttribute) ApplyNestedOverride(path []string, overr
 	return a, err
 }
 
+func (a *ResourceSingleNestedAttribute) ReplaceNestedAttribute(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.Attributes = a.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *ResourceSingleNestedAttribute) ToSpec() resource.Attribute {
 	a.SingleNestedAttribute.Attributes = a.Attributes.ToSpec()
 
@@ -72,21 +79,22 @@ func (a *DataSourceSingleNestedAttribute) GetName() string {
 
 func (a *DataSourceSingleNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	singleNestedAttribute, ok := mergeAttribute.(*DataSourceSingleNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newMergeConflictError(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = singleNestedAttribute.Description
 	}
-	a.Attributes, _ = a.Attributes.Merge(singleNestedAttribute.Attributes)
 
-	return a, nil
+	var err error
+	a.Attributes, err = a.Attributes.Merge(singleNestedAttribute.Attributes)
+
+	return a, nestMergeErrors(a.Name, err)
 }
 
 func (a *DataSourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -98,6 +106,12 @@ func (a *DataSourceSingleNestedAttribute) ApplyNestedOverride(path []string, ove
 	return a, err
 }
 
+func (a *DataSourceSingleNestedAttribute) ReplaceNestedAttribute(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.Attributes = a.Attributes.ReplaceAttribute(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceSingleNestedAttribute) ToSpec() datasource.Attribute {
 	a.SingleNestedAttribute.Attributes = a.Attributes.ToSpec()
 
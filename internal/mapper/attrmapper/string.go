@@ -23,8 +23,11 @@ func (a *ResourceStringAttribute) GetName() string {
 
 func (a *ResourceStringAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	stringAttribute, ok := mergeAttribute.(*ResourceStringAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = stringAttribute.Description
 	}
 
@@ -32,7 +35,7 @@ func (a *ResourceStringAttribute) Merge(mergeAttribute ResourceAttribute) (Resou
 }
 
 func (a *ResourceStringAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
@@ -56,8 +59,11 @@ func (a *DataSourceStringAttribute) GetName() string {
 
 func (a *DataSourceStringAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	stringAttribute, ok := mergeAttribute.(*DataSourceStringAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newMergeConflictError(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = stringAttribute.Description
 	}
 
@@ -65,7 +71,7 @@ func (a *DataSourceStringAttribute) Merge(mergeAttribute DataSourceAttribute) (D
 }
 
 func (a *DataSourceStringAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	applyOverrideFields(override, &a.ComputedOptionalRequired, &a.DeprecationMessage, &a.Description, &a.Sensitive)
 
 	return a, nil
 }
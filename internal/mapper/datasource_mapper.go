@@ -4,6 +4,7 @@
 package mapper
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -25,8 +26,7 @@ type DataSourceMapper interface {
 
 type dataSourceMapper struct {
 	dataSources map[string]explorer.DataSource
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg         config.Config
 }
 
 func NewDataSourceMapper(dataSources map[string]explorer.DataSource, cfg config.Config) DataSourceMapper {
@@ -45,8 +45,12 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource,
 		dataSource := m.dataSources[name]
 		dLogger := logger.With("data_source", name)
 
-		schema, err := generateDataSourceSchema(dLogger, name, dataSource)
+		schema, err := generateDataSourceSchema(dLogger, name, dataSource, m.cfg.Options)
 		if err != nil {
+			if isFatalMappingError(err) {
+				return nil, fmt.Errorf("data source '%s': %w", name, err)
+			}
+
 			log.WarnLogOnError(dLogger, err, "skipping data source schema mapping")
 			continue
 		}
@@ -60,7 +64,7 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource,
 	return dataSourceSchemas, nil
 }
 
-func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource) (*datasource.Schema, error) {
+func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource, opts config.Options) (*datasource.Schema, error) {
 	dataSourceSchema := &datasource.Schema{
 		Attributes: []datasource.Attribute{},
 	}
@@ -72,14 +76,17 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 
 	schemaOpts := oas.SchemaOpts{
 		Ignores: dataSource.SchemaOptions.Ignores,
+		Aliases: dataSource.SchemaOptions.AttributeOptions.Aliases,
 	}
 	globalSchemaOpts := oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		DefaultIntegerFormat:  opts.DefaultIntegerFormat,
 	}
 	readResponseSchema, err := oas.BuildSchemaFromResponse(dataSource.ReadOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
+	mappedSchemas := []*oas.OASSchema{readResponseSchema}
 
 	readResponseAttributes := attrmapper.DataSourceAttributes{}
 	if readResponseSchema.Type == util.OAS_type_array {
@@ -107,6 +114,8 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 	// READ Parameters (optional)
 	// ****************
 	readParameterAttributes := attrmapper.DataSourceAttributes{}
+	readParameterNames := []string{}
+	readParameterOASNames := []string{}
 	for _, param := range dataSource.ReadOpParameters() {
 		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
 			continue
@@ -118,7 +127,11 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 			OverrideDescription: param.Description,
 		}
 
-		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, oas.GlobalSchemaOpts{})
+		globalSchemaOpts := oas.GlobalSchemaOpts{
+			DefaultIntegerFormat: opts.DefaultIntegerFormat,
+		}
+
+		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
 		if schemaErr != nil {
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
@@ -130,11 +143,13 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		}
 
 		// Check for any aliases and replace the paramater name if found
+		readParameterOASNames = append(readParameterOASNames, param.Name)
 		paramName := param.Name
 		if aliasedName, ok := dataSource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
 			pLogger = pLogger.With("param_alias", aliasedName)
 			paramName = aliasedName
 		}
+		readParameterNames = append(readParameterNames, paramName)
 
 		if s.IsPropertyIgnored(paramName) {
 			continue
@@ -149,11 +164,20 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		readParameterAttributes = append(readParameterAttributes, parameterAttribute)
 	}
 
-	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
-	dataSourceAttributes, _ := readParameterAttributes.Merge(readResponseAttributes)
+	dataSourceAttributes, err := mergeDataSourceAttributes(logger, opts.MergeStrategy, readParameterAttributes, readResponseAttributes, readResponseSource)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: handle error for overrides
-	dataSourceAttributes, _ = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	dataSourceAttributes, err = applyOverrides(dataSourceAttributes, dataSource.SchemaOptions.AttributeOptions)
+	err = errors.Join(
+		checkUnusedIgnores(logger, opts.Strict, explicitIgnores(dataSource.SchemaOptions), mappedSchemas, readParameterNames),
+		checkUnusedAliases(logger, opts.Strict, explicitAliases(dataSource.SchemaOptions.AttributeOptions), mappedSchemas, readParameterOASNames),
+		checkOverrideErrors(logger, opts.Strict, err),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	dataSourceSchema.Attributes = dataSourceAttributes.ToSpec()
 	return dataSourceSchema, nil
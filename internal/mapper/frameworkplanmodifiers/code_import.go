@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import "github.com/greatman/terraform-plugin-codegen-spec/code"
+
+const (
+	// CodeImportBasePath is the base code import path for framework plan modifiers.
+	CodeImportBasePath = "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// CodeImport returns the framework plan modifiers code import for the given path.
+func CodeImport(packagePath string) code.Import {
+	return code.Import{
+		Path: CodeImportBasePath + "/" + packagePath,
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package frameworkplanmodifiers contains functionality for mapping plan
+// modifiers onto specification that uses the terraform-plugin-framework
+// resource schema plan modifier packages.
+//
+// Currently, the specification requires all plan modifiers to be written as
+// "custom" plan modifiers.
+package frameworkplanmodifiers
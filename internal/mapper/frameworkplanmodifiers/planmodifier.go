@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+// Names of the plan modifier packages for each attribute type in the
+// framework module resource schema package.
+const (
+	BoolPlanModifierPackage    = "boolplanmodifier"
+	Float64PlanModifierPackage = "float64planmodifier"
+	Int32PlanModifierPackage   = "int32planmodifier"
+	Int64PlanModifierPackage   = "int64planmodifier"
+	ListPlanModifierPackage    = "listplanmodifier"
+	MapPlanModifierPackage     = "mapplanmodifier"
+	NumberPlanModifierPackage  = "numberplanmodifier"
+	ObjectPlanModifierPackage  = "objectplanmodifier"
+	SetPlanModifierPackage     = "setplanmodifier"
+	StringPlanModifierPackage  = "stringplanmodifier"
+)
+
+// RequiresReplace returns a custom plan modifier mapped to the RequiresReplace
+// function of the given plan modifier package, i.e. "stringplanmodifier".
+func RequiresReplace(planModifierPackage string) *schema.CustomPlanModifier {
+	return customPlanModifier(planModifierPackage, "RequiresReplace")
+}
+
+// UseStateForUnknown returns a custom plan modifier mapped to the
+// UseStateForUnknown function of the given plan modifier package, i.e.
+// "stringplanmodifier".
+func UseStateForUnknown(planModifierPackage string) *schema.CustomPlanModifier {
+	return customPlanModifier(planModifierPackage, "UseStateForUnknown")
+}
+
+func customPlanModifier(planModifierPackage string, function string) *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(planModifierPackage)
+	schemaDefinition.WriteString(".")
+	schemaDefinition.WriteString(function)
+	schemaDefinition.WriteString("()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			CodeImport(planModifierPackage),
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
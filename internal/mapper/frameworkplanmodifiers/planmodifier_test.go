@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+)
+
+func TestRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		planModifierPackage string
+		expected            *schema.CustomPlanModifier
+	}{
+		"string": {
+			planModifierPackage: frameworkplanmodifiers.StringPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+					},
+				},
+				SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+			},
+		},
+		"object": {
+			planModifierPackage: frameworkplanmodifiers.ObjectPlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier",
+					},
+				},
+				SchemaDefinition: "objectplanmodifier.RequiresReplace()",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkplanmodifiers.RequiresReplace(testCase.planModifierPackage)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUseStateForUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		planModifierPackage string
+		expected            *schema.CustomPlanModifier
+	}{
+		"int64": {
+			planModifierPackage: frameworkplanmodifiers.Int64PlanModifierPackage,
+			expected: &schema.CustomPlanModifier{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier",
+					},
+				},
+				SchemaDefinition: "int64planmodifier.UseStateForUnknown()",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkplanmodifiers.UseStateForUnknown(testCase.planModifierPackage)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// BoolValidatorPackage is the name of the bool validation package in
+	// the framework validators module.
+	BoolValidatorPackage = "boolvalidator"
+)
+
+var (
+	// BoolValidatorCodeImport is a single allocation of the framework
+	// validators module boolvalidator package import.
+	BoolValidatorCodeImport code.Import = CodeImport(BoolValidatorPackage)
+)
+
+// BoolValidatorEquals returns a custom validator mapped to the
+// boolvalidator package Equals function.
+func BoolValidatorEquals(value bool) *schema.CustomValidator {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(BoolValidatorPackage)
+	schemaDefinition.WriteString(".Equals(")
+	schemaDefinition.WriteString(strconv.FormatBool(value))
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			BoolValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
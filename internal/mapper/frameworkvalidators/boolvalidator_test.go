@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+)
+
+func TestBoolValidatorEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    bool
+		expected *schema.CustomValidator
+	}{
+		"true": {
+			value: true,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+					},
+				},
+				SchemaDefinition: "boolvalidator.Equals(true)",
+			},
+		},
+		"false": {
+			value: false,
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+					},
+				},
+				SchemaDefinition: "boolvalidator.Equals(false)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.BoolValidatorEquals(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -94,3 +94,43 @@ func ListValidatorUniqueValues() *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// ListValidatorValueStringsAre returns a custom validator mapped to the
+// listvalidator package ValueStringsAre function, which validates each string
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func ListValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(ListValidatorCodeImport, ListValidatorPackage, "ValueStringsAre", validators)
+}
+
+// ListValidatorValueInt32sAre returns a custom validator mapped to the
+// listvalidator package ValueInt32sAre function, which validates each int32
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func ListValidatorValueInt32sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(ListValidatorCodeImport, ListValidatorPackage, "ValueInt32sAre", validators)
+}
+
+// ListValidatorValueInt64sAre returns a custom validator mapped to the
+// listvalidator package ValueInt64sAre function, which validates each int64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func ListValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(ListValidatorCodeImport, ListValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// ListValidatorValueFloat64sAre returns a custom validator mapped to the
+// listvalidator package ValueFloat64sAre function, which validates each float64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func ListValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(ListValidatorCodeImport, ListValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// ListValidatorValueNumbersAre returns a custom validator mapped to the
+// listvalidator package ValueNumbersAre function, which validates each number
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func ListValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(ListValidatorCodeImport, ListValidatorPackage, "ValueNumbersAre", validators)
+}
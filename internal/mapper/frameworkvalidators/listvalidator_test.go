@@ -148,3 +148,66 @@ func TestListValidatorUniqueValues(t *testing.T) {
 		})
 	}
 }
+
+func TestListValidatorValueStringsAre(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			validators: nil,
+			expected:   nil,
+		},
+		"one": {
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorOneOf([]string{"a", "b"}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.OneOf(\n\"a\",\n\"b\",\n),\n)",
+			},
+		},
+		"multiple": {
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+				frameworkvalidators.StringValidatorRegexMatches("^[a-z]+$", ""),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.LengthAtLeast(1),\nstringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.ListValidatorValueStringsAre(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -78,3 +78,43 @@ func MapValidatorSizeBetween(minimum, maximum int64) *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// MapValidatorValueStringsAre returns a custom validator mapped to the
+// mapvalidator package ValueStringsAre function, which validates each string
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func MapValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(MapValidatorCodeImport, MapValidatorPackage, "ValueStringsAre", validators)
+}
+
+// MapValidatorValueInt32sAre returns a custom validator mapped to the
+// mapvalidator package ValueInt32sAre function, which validates each int32
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func MapValidatorValueInt32sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(MapValidatorCodeImport, MapValidatorPackage, "ValueInt32sAre", validators)
+}
+
+// MapValidatorValueInt64sAre returns a custom validator mapped to the
+// mapvalidator package ValueInt64sAre function, which validates each int64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func MapValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(MapValidatorCodeImport, MapValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// MapValidatorValueFloat64sAre returns a custom validator mapped to the
+// mapvalidator package ValueFloat64sAre function, which validates each float64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func MapValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(MapValidatorCodeImport, MapValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// MapValidatorValueNumbersAre returns a custom validator mapped to the
+// mapvalidator package ValueNumbersAre function, which validates each number
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func MapValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(MapValidatorCodeImport, MapValidatorPackage, "ValueNumbersAre", validators)
+}
@@ -116,3 +116,49 @@ func TestMapValidatorSizeBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValidatorValueNumbersAre(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			validators: nil,
+			expected:   nil,
+		},
+		"one": {
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.NumberValidatorOneOf([]float64{1.5}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueNumbersAre(\nnumbervalidator.OneOf(\nbig.NewFloat(1.5),\n),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.MapValidatorValueNumbersAre(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+)
+
+func TestNumberValidatorOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values   []float64
+		expected *schema.CustomValidator
+	}{
+		"nil": {
+			values:   nil,
+			expected: nil,
+		},
+		"empty": {
+			values:   []float64{},
+			expected: nil,
+		},
+		"one": {
+			values: []float64{1.2},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "numbervalidator.OneOf(\nbig.NewFloat(1.2),\n)",
+			},
+		},
+		"multiple": {
+			values: []float64{1.2, 3},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "numbervalidator.OneOf(\nbig.NewFloat(1.2),\nbig.NewFloat(3),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.NumberValidatorOneOf(testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectValidatorPackage is the name of the object validation package in
+	// the framework validators module.
+	ObjectValidatorPackage = "objectvalidator"
+
+	// PathPackage is the name of the path package in the framework module.
+	PathPackage = "path"
+)
+
+var (
+	// ObjectValidatorCodeImport is a single allocation of the framework
+	// validators module objectvalidator package import.
+	ObjectValidatorCodeImport code.Import = CodeImport(ObjectValidatorPackage)
+
+	// PathCodeImport is a single allocation of the framework module path
+	// package import.
+	PathCodeImport code.Import = code.Import{
+		Path: "github.com/hashicorp/terraform-plugin-framework/" + PathPackage,
+	}
+)
+
+// ObjectValidatorExactlyOneOf returns a custom validator mapped to the
+// objectvalidator package ExactlyOneOf function. Each attribute name is
+// converted to a path expression relative to the parent of the attribute the
+// validator is applied to. If the attribute names are nil or empty, nil is
+// returned.
+func ObjectValidatorExactlyOneOf(attributeNames []string) *schema.CustomValidator {
+	if len(attributeNames) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(ObjectValidatorPackage)
+	schemaDefinition.WriteString(".ExactlyOneOf(\n")
+
+	for _, attributeName := range attributeNames {
+		schemaDefinition.WriteString(PathPackage)
+		schemaDefinition.WriteString(".MatchRelative().AtParent().AtName(")
+		schemaDefinition.WriteString(strconv.Quote(attributeName))
+		schemaDefinition.WriteString("),\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			PathCodeImport,
+			ObjectValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
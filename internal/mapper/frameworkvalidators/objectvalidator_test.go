@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+)
+
+func TestObjectValidatorExactlyOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeNames []string
+		expected       *schema.CustomValidator
+	}{
+		"nil": {
+			attributeNames: nil,
+			expected:       nil,
+		},
+		"empty": {
+			attributeNames: []string{},
+			expected:       nil,
+		},
+		"multiple": {
+			attributeNames: []string{"git", "s3"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"git\"),\npath.MatchRelative().AtParent().AtName(\"s3\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.ObjectValidatorExactlyOneOf(testCase.attributeNames)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -78,3 +78,43 @@ func SetValidatorSizeBetween(minimum, maximum int64) *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// SetValidatorValueStringsAre returns a custom validator mapped to the
+// setvalidator package ValueStringsAre function, which validates each string
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func SetValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(SetValidatorCodeImport, SetValidatorPackage, "ValueStringsAre", validators)
+}
+
+// SetValidatorValueInt32sAre returns a custom validator mapped to the
+// setvalidator package ValueInt32sAre function, which validates each int32
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func SetValidatorValueInt32sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(SetValidatorCodeImport, SetValidatorPackage, "ValueInt32sAre", validators)
+}
+
+// SetValidatorValueInt64sAre returns a custom validator mapped to the
+// setvalidator package ValueInt64sAre function, which validates each int64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func SetValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(SetValidatorCodeImport, SetValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// SetValidatorValueFloat64sAre returns a custom validator mapped to the
+// setvalidator package ValueFloat64sAre function, which validates each float64
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func SetValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(SetValidatorCodeImport, SetValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// SetValidatorValueNumbersAre returns a custom validator mapped to the
+// setvalidator package ValueNumbersAre function, which validates each number
+// element with the given validators. If the validators are nil or empty, nil
+// is returned.
+func SetValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return valuesAre(SetValidatorCodeImport, SetValidatorPackage, "ValueNumbersAre", validators)
+}
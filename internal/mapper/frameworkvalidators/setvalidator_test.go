@@ -116,3 +116,46 @@ func TestSetValidatorSizeBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValidatorValueInt64sAre(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			validators: nil,
+			expected:   nil,
+		},
+		"one": {
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorOneOf([]int64{80, 443}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueInt64sAre(\nint64validator.OneOf(\n80,\n443,\n),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.SetValidatorValueInt64sAre(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
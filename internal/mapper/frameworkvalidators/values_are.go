@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+// valuesAre returns a custom validator mapped to a collection validation
+// package function that validates each element, such as the listvalidator
+// package ValueStringsAre function. The imports of the element validators are
+// included after the collection validation package import. If the element
+// validators are nil or empty, nil is returned.
+func valuesAre(packageImport code.Import, packageName string, function string, validators []*schema.CustomValidator) *schema.CustomValidator {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(packageName)
+	schemaDefinition.WriteString("." + function + "(\n")
+
+	imports := []code.Import{
+		packageImport,
+	}
+	importPaths := map[string]bool{
+		packageImport.Path: true,
+	}
+
+	for _, validator := range validators {
+		schemaDefinition.WriteString(validator.SchemaDefinition + ",\n")
+
+		for _, validatorImport := range validator.Imports {
+			if importPaths[validatorImport.Path] {
+				continue
+			}
+
+			importPaths[validatorImport.Path] = true
+			imports = append(imports, validatorImport)
+		}
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports:          imports,
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
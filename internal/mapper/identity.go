@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// ResourceIdentity maps the attribute that identifies a resource to the path parameter of the read operation. This isn't part of
+// the provider code spec, but is recorded alongside the resource schema for downstream code generators.
+type ResourceIdentity struct {
+	// Attribute is the name of the identity attribute, which is converted to a Terraform identifier once the resource is mapped.
+	Attribute string `json:"attribute"`
+
+	// Parameter is the read operation path parameter that the identity attribute is mapped from.
+	Parameter string `json:"parameter"`
+
+	// Path is the path template of the read operation, i.e. `/widgets/{widgetId}`.
+	Path string `json:"path"`
+}
+
+// defaultIdentityAttribute is the read response body property that a resource identity is detected for.
+const defaultIdentityAttribute = "id"
+
+// resolveIdentity finds the path parameter of the read operation that identifies a resource. If the resource doesn't declare an
+// identity, it is detected with detectIdentity. If the identity parameter isn't declared, the last path parameter of the read path is used.
+func resolveIdentity(explorerResource explorer.Resource) (*ResourceIdentity, error) {
+	if explorerResource.Identity == nil {
+		return detectIdentity(explorerResource), nil
+	}
+
+	parameter := explorerResource.Identity.Parameter
+	if parameter == "" {
+		pathParameters := util.PathParameters(explorerResource.ReadPath)
+		if len(pathParameters) == 0 {
+			return nil, fmt.Errorf("unable to determine identity parameter, read path '%s' has no path parameters", explorerResource.ReadPath)
+		}
+
+		parameter = pathParameters[len(pathParameters)-1]
+	}
+
+	isPathParameter := slices.ContainsFunc(explorerResource.ReadOpParameters(), func(param *high.Parameter) bool {
+		return param.In == util.OAS_param_path && param.Name == parameter
+	})
+	if !isPathParameter {
+		return nil, fmt.Errorf("identity parameter '%s' is not a path parameter of the read operation", parameter)
+	}
+
+	return &ResourceIdentity{
+		Attribute: explorerResource.Identity.Attribute,
+		Parameter: parameter,
+		Path:      explorerResource.ReadPath,
+	}, nil
+}
+
+// detectIdentity returns the identity of a resource that doesn't declare one, which is the last path parameter of the read path
+// if the read response body has an `id` property of the same type, i.e. `widgetId` and `id` for `/widgets/{widgetId}`. Returns
+// nil if the path parameter is already named `id`, is aliased or ignored, or if the `id` property is aliased or ignored.
+func detectIdentity(explorerResource explorer.Resource) *ResourceIdentity {
+	pathParameters := util.PathParameters(explorerResource.ReadPath)
+	if len(pathParameters) == 0 {
+		return nil
+	}
+
+	parameter := pathParameters[len(pathParameters)-1]
+	if parameter == defaultIdentityAttribute {
+		return nil
+	}
+
+	schemaOpts := oas.SchemaOpts{
+		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases: explorerResource.SchemaOptions.AttributeOptions.Aliases,
+	}
+	paramIndex := slices.IndexFunc(explorerResource.ReadOpParameters(), func(param *high.Parameter) bool {
+		return param.In == util.OAS_param_path && param.Name == parameter
+	})
+	if paramIndex == -1 {
+		return nil
+	}
+
+	paramSchema, schemaErr := oas.BuildSchema(explorerResource.ReadOpParameters()[paramIndex].Schema, schemaOpts, oas.GlobalSchemaOpts{})
+	if schemaErr != nil || paramSchema.IsPropertyIgnored(parameter) || paramSchema.GetAliasedName(parameter) != parameter {
+		return nil
+	}
+
+	responseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, oas.GlobalSchemaOpts{})
+	if err != nil || responseSchema.Type != util.OAS_type_object || responseSchema.Schema.Properties == nil {
+		return nil
+	}
+
+	if responseSchema.IsPropertyIgnored(defaultIdentityAttribute) || responseSchema.GetAliasedName(defaultIdentityAttribute) != defaultIdentityAttribute {
+		return nil
+	}
+
+	idProxy, ok := responseSchema.Schema.Properties.Get(defaultIdentityAttribute)
+	if !ok {
+		return nil
+	}
+
+	idSchema, schemaErr := oas.BuildSchema(idProxy, schemaOpts, oas.GlobalSchemaOpts{})
+	if schemaErr != nil || idSchema.Type != paramSchema.Type {
+		return nil
+	}
+
+	return &ResourceIdentity{
+		Attribute: defaultIdentityAttribute,
+		Parameter: parameter,
+		Path:      explorerResource.ReadPath,
+	}
+}
+
+// isIdentityParameter checks if a read operation parameter is the identity parameter of a resource.
+func (i *ResourceIdentity) isIdentityParameter(param *high.Parameter) bool {
+	return i != nil && param.In == util.OAS_param_path && param.Name == i.Parameter
+}
+
+// readParameterAttributeName returns the name of the attribute that a read operation parameter is mapped to, which is either the
+// identity attribute, an alias, or the parameter name.
+func readParameterAttributeName(explorerResource explorer.Resource, identity *ResourceIdentity, param *high.Parameter) string {
+	if identity.isIdentityParameter(param) {
+		return identity.Attribute
+	}
+
+	if aliasedName, ok := explorerResource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
+		return aliasedName
+	}
+
+	return param.Name
+}
+
+// applyIdentity makes the identity attribute computed and optional, as the identity is set by the API on create and is then used
+// to read the resource. Any override for the identity attribute in the generator config is applied afterwards. Returns an error if
+// the identity attribute isn't mapped, see applyDetectedIdentity for identities that weren't declared in the generator config.
+func applyIdentity(attributes attrmapper.ResourceAttributes, identity *ResourceIdentity) (attrmapper.ResourceAttributes, error) {
+	attributes, err := attributes.ApplyOverrides(map[string]explorer.Override{
+		identity.Attribute: {
+			ComputedOptionalRequired: schema.ComputedOptional,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("identity attribute '%s': %w", identity.Attribute, err)
+	}
+
+	return attributes, nil
+}
+
+// applyDetectedIdentity applies a detected identity with applyIdentity. If the identity attribute isn't mapped, a warning is logged
+// and the attributes are returned unchanged with a nil identity, as only an identity declared in the generator config must be applied.
+func applyDetectedIdentity(logger *slog.Logger, attributes attrmapper.ResourceAttributes, identity *ResourceIdentity) (attrmapper.ResourceAttributes, *ResourceIdentity) {
+	identityAttributes, err := applyIdentity(attributes, identity)
+	if err != nil {
+		log.WarnLogOnError(logger, err, "ignoring detected resource identity")
+		return attributes, nil
+	}
+
+	return identityAttributes, identity
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+)
+
+// mergeSource describes where a set of attributes being merged was mapped from, used for reporting merge conflicts.
+type mergeSource struct {
+	name       string
+	isResponse bool
+}
+
+var (
+	updateRequestSource  = mergeSource{name: "update operation request body"}
+	createResponseSource = mergeSource{name: "create operation response body", isResponse: true}
+	readResponseSource   = mergeSource{name: "read operation response body", isResponse: true}
+	readParameterSource  = mergeSource{name: "read operation parameters"}
+)
+
+// mergeResourceAttributes merges the source attributes into the target attributes, reporting any attribute type conflicts
+// based on the merge strategy:
+//   - warn (default): Log a warning and keep the target attribute
+//   - fail: Log a warning and return all conflicts as an error
+//   - prefer-response: Log a warning and replace the target attribute if the source is a response body
+func mergeResourceAttributes(logger *slog.Logger, strategy string, target attrmapper.ResourceAttributes, source attrmapper.ResourceAttributes, from mergeSource) (attrmapper.ResourceAttributes, error) {
+	result, err := target.Merge(source)
+
+	var errResult error
+	for _, conflict := range attrmapper.MergeConflicts(err) {
+		logMergeConflict(logger, conflict, from)
+
+		switch strategy {
+		case config.MergeStrategyFail:
+			errResult = errors.Join(errResult, conflict)
+		case config.MergeStrategyPreferResponse:
+			replacement, ok := conflict.MergeAttribute.(attrmapper.ResourceAttribute)
+			if ok && from.isResponse {
+				result = result.ReplaceAttribute(conflict.Path, replacement)
+			}
+		}
+	}
+
+	return result, errResult
+}
+
+// mergeDataSourceAttributes merges the source attributes into the target attributes, reporting any attribute type conflicts
+// based on the merge strategy. See mergeResourceAttributes for a description of each merge strategy.
+func mergeDataSourceAttributes(logger *slog.Logger, strategy string, target attrmapper.DataSourceAttributes, source attrmapper.DataSourceAttributes, from mergeSource) (attrmapper.DataSourceAttributes, error) {
+	result, err := target.Merge(source)
+
+	var errResult error
+	for _, conflict := range attrmapper.MergeConflicts(err) {
+		logMergeConflict(logger, conflict, from)
+
+		switch strategy {
+		case config.MergeStrategyFail:
+			errResult = errors.Join(errResult, conflict)
+		case config.MergeStrategyPreferResponse:
+			replacement, ok := conflict.MergeAttribute.(attrmapper.DataSourceAttribute)
+			if ok && from.isResponse {
+				result = result.ReplaceAttribute(conflict.Path, replacement)
+			}
+		}
+	}
+
+	return result, errResult
+}
+
+func logMergeConflict(logger *slog.Logger, conflict *attrmapper.MergeConflictError, from mergeSource) {
+	logger.Warn(
+		"attribute type conflict during merge",
+		"attribute", conflict.AttributePath(),
+		"target_type", conflict.TargetType,
+		"merge_type", conflict.MergeType,
+		"source", from.name,
+	)
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// mergeAllOfSchemas will deep merge the parent schema with all of its allOf subschemas, returning a single schema. Each subschema
+// is built (and recursively resolved) before being merged in order, with the following rules:
+//   - type: The intersection of all types is used, an empty intersection is a conflict
+//   - format: Must match if defined in multiple schemas, otherwise it's a conflict
+//   - properties: Union of all properties, properties defined in multiple schemas are deep merged with these same rules
+//   - required: Union of all required properties
+//   - enum: Intersection of all enum values, an empty intersection is a conflict
+//   - minimum/maximum, length, item, and property validators: The most restrictive value is used
+//   - pattern: Must match if defined in multiple schemas, otherwise it's a conflict
+//   - multipleOf: The larger value is used if it's a multiple of the smaller value, otherwise it's a conflict
+//   - nullable: Enabled if every schema that defines it has it enabled
+//   - description, default, and extensions: The parent value is preferred, then the first subschema with the value
+//
+// Any conflict will return a SchemaError, with the line number of the allOf keyword if available.
+func mergeAllOfSchemas(parent *base.Schema) (*base.Schema, *SchemaError) {
+	// Start with a shallow copy of the parent, which preserves the low-level model for line numbers
+	merged := *parent
+	merged.AllOf = nil
+	merged.Properties = copyProperties(parent.Properties)
+	merged.Required = slices.Clone(parent.Required)
+	merged.Extensions = copyExtensions(parent.Extensions)
+
+	for i, proxy := range parent.AllOf {
+		subschema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		mergeErr := mergeSchemas(&merged, subschema)
+		if mergeErr != nil {
+			allOfErr := SchemaErrorFromNode(fmt.Errorf("allOf subschema at index %d - %w", i, mergeErr), parent, AllOf)
+
+			// Preserve the path and line number of nested property conflicts
+			allOfErr.path = mergeErr.path
+			if mergeErr.LineNumber() != 0 {
+				allOfErr.lineNumber = mergeErr.LineNumber()
+			}
+
+			return nil, allOfErr
+		}
+	}
+
+	return &merged, nil
+}
+
+// mergeSchemas merges the source schema into the target schema, following the rules defined in mergeAllOfSchemas.
+func mergeSchemas(target *base.Schema, source *base.Schema) *SchemaError {
+	mergedType, err := mergeTypes(target.Type, source.Type)
+	if err != nil {
+		return emptySchemaError(err)
+	}
+	target.Type = mergedType
+
+	if target.Format == "" {
+		target.Format = source.Format
+	} else if source.Format != "" && source.Format != target.Format {
+		return emptySchemaError(fmt.Errorf("conflicting formats '%s' and '%s'", target.Format, source.Format))
+	}
+
+	if target.Description == "" {
+		target.Description = source.Description
+	}
+	if target.Default == nil {
+		target.Default = source.Default
+	}
+	if target.Pattern == "" {
+		target.Pattern = source.Pattern
+	} else if source.Pattern != "" && source.Pattern != target.Pattern {
+		return emptySchemaError(fmt.Errorf("conflicting patterns '%s' and '%s'", target.Pattern, source.Pattern))
+	}
+
+	target.MultipleOf, err = mergeMultipleOf(target.MultipleOf, source.MultipleOf)
+	if err != nil {
+		return emptySchemaError(err)
+	}
+
+	target.Deprecated = orBool(target.Deprecated, source.Deprecated)
+	target.UniqueItems = orBool(target.UniqueItems, source.UniqueItems)
+	target.Nullable = andBool(target.Nullable, source.Nullable)
+
+	if source.Extensions != nil {
+		if target.Extensions == nil {
+			target.Extensions = orderedmap.New[string, *yaml.Node]()
+		}
+		for pair := range orderedmap.Iterate(context.TODO(), source.Extensions) {
+			if _, ok := target.Extensions.Get(pair.Key()); !ok {
+				target.Extensions.Set(pair.Key(), pair.Value())
+			}
+		}
+	}
+
+	target.Minimum = largestValue(target.Minimum, source.Minimum)
+	target.Maximum = smallestValue(target.Maximum, source.Maximum)
+	target.MinLength = largestValue(target.MinLength, source.MinLength)
+	target.MaxLength = smallestValue(target.MaxLength, source.MaxLength)
+	target.MinItems = largestValue(target.MinItems, source.MinItems)
+	target.MaxItems = smallestValue(target.MaxItems, source.MaxItems)
+	target.MinProperties = largestValue(target.MinProperties, source.MinProperties)
+	target.MaxProperties = smallestValue(target.MaxProperties, source.MaxProperties)
+
+	target.Enum, err = mergeEnums(target.Enum, source.Enum)
+	if err != nil {
+		return emptySchemaError(err)
+	}
+
+	for _, required := range source.Required {
+		if !slices.Contains(target.Required, required) {
+			target.Required = append(target.Required, required)
+		}
+	}
+
+	if target.Items == nil {
+		target.Items = source.Items
+	} else if target.Items.IsA() && source.Items != nil && source.Items.IsA() {
+		mergedItems, schemaErr := mergeSchemaProxies(target.Items.A, source.Items.A)
+		if schemaErr != nil {
+			return schemaErr
+		}
+		target.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: mergedItems}
+	}
+
+	if target.AdditionalProperties == nil {
+		target.AdditionalProperties = source.AdditionalProperties
+	} else if target.AdditionalProperties.IsA() && source.AdditionalProperties != nil && source.AdditionalProperties.IsA() {
+		mergedAdditionalProperties, schemaErr := mergeSchemaProxies(target.AdditionalProperties.A, source.AdditionalProperties.A)
+		if schemaErr != nil {
+			return schemaErr
+		}
+		target.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{A: mergedAdditionalProperties}
+	}
+
+	if source.Properties == nil {
+		return nil
+	}
+	if target.Properties == nil {
+		target.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), source.Properties) {
+		name := pair.Key()
+
+		existingProxy, ok := target.Properties.Get(name)
+		if !ok {
+			target.Properties.Set(name, pair.Value())
+			continue
+		}
+
+		mergedProxy, schemaErr := mergeSchemaProxies(existingProxy, pair.Value())
+		if schemaErr != nil {
+			return schemaErr.NestedSchemaError(name, getSchemaPropertyLineNumber(source, name))
+		}
+		target.Properties.Set(name, mergedProxy)
+	}
+
+	return nil
+}
+
+// mergeSchemaProxies builds and deep merges two schema proxies, returning a new schema proxy for the merged result.
+func mergeSchemaProxies(targetProxy *base.SchemaProxy, sourceProxy *base.SchemaProxy) (*base.SchemaProxy, *SchemaError) {
+	targetSchema, err := buildSchemaProxy(targetProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceSchema, err := buildSchemaProxy(sourceProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *targetSchema
+	merged.Properties = copyProperties(targetSchema.Properties)
+	merged.Required = slices.Clone(targetSchema.Required)
+	merged.Extensions = copyExtensions(targetSchema.Extensions)
+
+	err = mergeSchemas(&merged, sourceSchema)
+	if err != nil {
+		if err.LineNumber() == 0 {
+			return nil, SchemaErrorFromProxy(err, sourceProxy)
+		}
+		return nil, err
+	}
+
+	return base.CreateSchemaProxy(&merged), nil
+}
+
+// mergeTypes returns the intersection of two JSON schema type arrays. If either array is empty, the other is returned.
+func mergeTypes(targetTypes []string, sourceTypes []string) ([]string, error) {
+	if len(targetTypes) == 0 {
+		return sourceTypes, nil
+	}
+	if len(sourceTypes) == 0 {
+		return targetTypes, nil
+	}
+
+	var result []string
+	for _, targetType := range targetTypes {
+		if slices.Contains(sourceTypes, targetType) {
+			result = append(result, targetType)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("conflicting types %v and %v", targetTypes, sourceTypes)
+	}
+
+	return result, nil
+}
+
+// mergeEnums returns the intersection of two enum value arrays, compared by their YAML tags and values, so the string "1" and the
+// integer 1 are different values. If either array is empty, the other is returned.
+func mergeEnums(targetEnum []*yaml.Node, sourceEnum []*yaml.Node) ([]*yaml.Node, error) {
+	if len(targetEnum) == 0 {
+		return sourceEnum, nil
+	}
+	if len(sourceEnum) == 0 {
+		return targetEnum, nil
+	}
+
+	var result []*yaml.Node
+	for _, targetValue := range targetEnum {
+		for _, sourceValue := range sourceEnum {
+			if targetValue.ShortTag() == sourceValue.ShortTag() && targetValue.Value == sourceValue.Value {
+				result = append(result, targetValue)
+				break
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("enums have no values in common")
+	}
+
+	return result, nil
+}
+
+// mergeMultipleOf returns the larger of two multipleOf values if it's a multiple of the smaller value, as any multiple of the larger
+// value is then also a multiple of the smaller value. If either value is nil, the other is returned.
+func mergeMultipleOf(target *float64, source *float64) (*float64, error) {
+	if target == nil {
+		return source, nil
+	}
+	if source == nil {
+		return target, nil
+	}
+
+	larger := max(*target, *source)
+	smaller := min(*target, *source)
+	if smaller == 0 || math.Mod(larger, smaller) != 0 {
+		return nil, fmt.Errorf("conflicting multipleOf values '%v' and '%v'", *target, *source)
+	}
+
+	return &larger, nil
+}
+
+// largestValue returns the largest of the two values if both are populated, otherwise the populated value.
+func largestValue[T int64 | float64](target *T, source *T) *T {
+	if target == nil {
+		return source
+	}
+	if source == nil {
+		return target
+	}
+
+	result := max(*target, *source)
+	return &result
+}
+
+// smallestValue returns the smallest of the two values if both are populated, otherwise the populated value.
+func smallestValue[T int64 | float64](target *T, source *T) *T {
+	if target == nil {
+		return source
+	}
+	if source == nil {
+		return target
+	}
+
+	result := min(*target, *source)
+	return &result
+}
+
+func orBool(target *bool, source *bool) *bool {
+	if target != nil && *target {
+		return target
+	}
+	if source != nil && *source {
+		return source
+	}
+
+	return target
+}
+
+// andBool returns whether both values are enabled if both are populated, otherwise the populated value.
+func andBool(target *bool, source *bool) *bool {
+	if target == nil {
+		return source
+	}
+	if source == nil {
+		return target
+	}
+
+	result := *target && *source
+	return &result
+}
+
+func copyProperties(properties *orderedmap.Map[string, *base.SchemaProxy]) *orderedmap.Map[string, *base.SchemaProxy] {
+	if properties == nil {
+		return nil
+	}
+
+	result := orderedmap.New[string, *base.SchemaProxy]()
+	for pair := range orderedmap.Iterate(context.TODO(), properties) {
+		result.Set(pair.Key(), pair.Value())
+	}
+
+	return result
+}
+
+func copyExtensions(extensions *orderedmap.Map[string, *yaml.Node]) *orderedmap.Map[string, *yaml.Node] {
+	if extensions == nil {
+		return nil
+	}
+
+	result := orderedmap.New[string, *yaml.Node]()
+	for pair := range orderedmap.Iterate(context.TODO(), extensions) {
+		result.Set(pair.Key(), pair.Value())
+	}
+
+	return result
+}
@@ -26,7 +26,9 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:      s.GetIgnoresForNested(name),
+			Aliases:      s.GetAliasesForNested(name),
+			ExactlyOneOf: s.GetUnionVariantNames(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -34,7 +36,7 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildResourceAttribute(name, s.GetComputability(name))
+		attribute, err := pSchema.BuildResourceAttribute(s.GetAliasedName(name), s.GetComputability(name))
 		if err != nil {
 			return nil, err
 		}
@@ -84,7 +86,9 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:      s.GetIgnoresForNested(name),
+			Aliases:      s.GetAliasesForNested(name),
+			ExactlyOneOf: s.GetUnionVariantNames(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -92,7 +96,7 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildDataSourceAttribute(name, s.GetComputability(name))
+		attribute, err := pSchema.BuildDataSourceAttribute(s.GetAliasedName(name), s.GetComputability(name))
 		if err != nil {
 			return nil, err
 		}
@@ -142,7 +146,9 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:      s.GetIgnoresForNested(name),
+			Aliases:      s.GetAliasesForNested(name),
+			ExactlyOneOf: s.GetUnionVariantNames(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
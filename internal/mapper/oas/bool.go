@@ -4,7 +4,10 @@
 package oas
 
 import (
+	"slices"
+
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
 	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
@@ -18,22 +21,24 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
-	if s.Schema.Default != nil {
-		var staticDefault bool
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
-			if computability == schema.Required {
-				result.ComputedOptionalRequired = schema.ComputedOptional
-			}
+	if staticDefault, ok := decodeDefault[bool](s, name); ok {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
 
-			result.Default = &schema.BoolDefault{
-				Static: &staticDefault,
-			}
+		result.Default = &schema.BoolDefault{
+			Static: &staticDefault,
 		}
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetBoolValidators()
+	}
+
 	return result, nil
 }
 
@@ -44,9 +49,14 @@ func (s *OASSchema) BuildBoolDataSource(name string, computability schema.Comput
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetBoolValidators()
+	}
+
 	return result, nil
 }
 
@@ -57,10 +67,27 @@ func (s *OASSchema) BuildBoolProvider(name string, optionalOrRequired schema.Opt
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetBoolValidators(),
 		},
 	}, nil
 }
 
+// GetBoolValidators maps an `enum` or `const` with a single allowed value, i.e. `const: true`, to an `Equals` validator. An `enum`
+// that allows both `true` and `false` doesn't need a validator.
+func (s *OASSchema) GetBoolValidators() []schema.BoolValidator {
+	enum := decodeEnumValues[bool](s)
+	if len(enum) == 0 || slices.Contains(enum, !enum[0]) {
+		return nil
+	}
+
+	return []schema.BoolValidator{
+		{
+			Custom: frameworkvalidators.BoolValidatorEquals(enum[0]),
+		},
+	}
+}
+
 func (s *OASSchema) BuildBoolElementType() (schema.ElementType, *SchemaError) {
 	return schema.ElementType{
 		Bool: &schema.BoolType{},
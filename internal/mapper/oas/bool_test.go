@@ -6,6 +6,7 @@ package oas_test
 import (
 	"testing"
 
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
 	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
@@ -109,6 +110,75 @@ func TestBuildBoolResource(t *testing.T) {
 				},
 			},
 		},
+		"boolean attributes const and enum": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"bool_prop_const"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"bool_prop_const": base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"boolean"},
+						Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "true"},
+					}),
+					"bool_prop_enum": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+					"bool_prop_enum_all_values": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "true"},
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_prop_const",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(true)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_prop_enum",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(false)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_prop_enum_all_values",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 		"boolean attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -236,6 +306,75 @@ func TestBuildBoolDataSource(t *testing.T) {
 				},
 			},
 		},
+		"boolean attributes const and enum": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"bool_prop_const"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"bool_prop_const": base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"boolean"},
+						Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "true"},
+					}),
+					"bool_prop_enum": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+					"bool_prop_enum_all_values": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "true"},
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceBoolAttribute{
+					Name: "bool_prop_const",
+					BoolAttribute: datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(true)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.DataSourceBoolAttribute{
+					Name: "bool_prop_enum",
+					BoolAttribute: datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(false)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.DataSourceBoolAttribute{
+					Name: "bool_prop_enum_all_values",
+					BoolAttribute: datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 		"boolean attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -363,6 +502,75 @@ func TestBuildBoolProvider(t *testing.T) {
 				},
 			},
 		},
+		"boolean attributes const and enum": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"bool_prop_const"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"bool_prop_const": base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"boolean"},
+						Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "true"},
+					}),
+					"bool_prop_enum": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+					"bool_prop_enum_all_values": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "true"},
+							{Kind: yaml.ScalarNode, Value: "false"},
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderBoolAttribute{
+					Name: "bool_prop_const",
+					BoolAttribute: provider.BoolAttribute{
+						OptionalRequired: schema.Required,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(true)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ProviderBoolAttribute{
+					Name: "bool_prop_enum",
+					BoolAttribute: provider.BoolAttribute{
+						OptionalRequired: schema.Optional,
+						Validators: schema.BoolValidators{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+										},
+									},
+									SchemaDefinition: "boolvalidator.Equals(false)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ProviderBoolAttribute{
+					Name: "bool_prop_enum_all_values",
+					BoolAttribute: provider.BoolAttribute{
+						OptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
 		"boolean attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
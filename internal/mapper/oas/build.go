@@ -118,9 +118,9 @@ func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts Glob
 }
 
 // buildSchemaProxy is a helper function that builds a schema proxy. If needed, it will recursively resolve a specific set of [schema composition] keywords:
-//   - allOf: If len == 1, will resolve with that one item.
-//   - anyOf: If len == 2, will resolve nullable or stringable types
-//   - oneOf: If len == 2, will resolve nullable or stringable types
+//   - allOf: If len == 1, will resolve with that one item. Otherwise, all subschemas will be deep merged into a single schema.
+//   - anyOf: If all non-null subschemas are objects, will resolve to an object with a property per subschema (union). If len == 2, will resolve nullable or stringable types
+//   - oneOf: If all non-null subschemas are objects, will resolve to an object with a property per subschema (union). If len == 2, will resolve nullable or stringable types
 //
 // # Any other combinations of allOf, anyOf, or oneOf will return a SchemaError
 //
@@ -137,8 +137,21 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	}
 
 	if len(s.AnyOf) > 0 {
+		variantSchemas, err := buildSchemaProxies(s.AnyOf)
+		if err != nil {
+			return nil, err
+		}
+
+		unionSchema, err := buildUnionSchema(s, s.AnyOf, variantSchemas, AnyOf)
+		if err != nil {
+			return nil, err
+		}
+		if unionSchema != nil {
+			return unionSchema, nil
+		}
+
 		if len(s.AnyOf) == 2 {
-			schema, err := getMultiTypeSchema(s.AnyOf[0], s.AnyOf[1])
+			schema, err := getMultiTypeSchema(variantSchemas[0], variantSchemas[1])
 			if err != nil {
 				return nil, err
 			}
@@ -151,8 +164,21 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	}
 
 	if len(s.OneOf) > 0 {
+		variantSchemas, err := buildSchemaProxies(s.OneOf)
+		if err != nil {
+			return nil, err
+		}
+
+		unionSchema, err := buildUnionSchema(s, s.OneOf, variantSchemas, OneOf)
+		if err != nil {
+			return nil, err
+		}
+		if unionSchema != nil {
+			return unionSchema, nil
+		}
+
 		if len(s.OneOf) == 2 {
-			schema, err := getMultiTypeSchema(s.OneOf[0], s.OneOf[1])
+			schema, err := getMultiTypeSchema(variantSchemas[0], variantSchemas[1])
 			if err != nil {
 				return nil, err
 			}
@@ -164,8 +190,8 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported", len(s.OneOf)), s, OneOf)
 	}
 
-	// If there is just one allOf, we can use it as the schema
-	if len(s.AllOf) == 1 {
+	// If there is just one allOf and no sibling properties, we can use it as the schema
+	if len(s.AllOf) == 1 && (s.Properties == nil || s.Properties.Len() == 0) {
 		allOfSchema, err := buildSchemaProxy(s.AllOf[0])
 		if err != nil {
 			return nil, err
@@ -179,24 +205,30 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		return allOfSchema, nil
 	}
 
-	// Combining multiple allOf schemas and their properties is possible here, but currently not supported
-	// See: https://github.com/hashicorp/terraform-plugin-codegen-openapi/issues/56
-	return nil, SchemaErrorFromNode(fmt.Errorf("found %d allOf subschema(s), schema composition is currently not supported", len(s.AllOf)), s, AllOf)
+	// Multiple allOf schemas (or an allOf with sibling properties) are deep merged into a single schema
+	return mergeAllOfSchemas(s)
 }
 
-// getMultiTypeSchema will check the types of both schemas provided and will return the non-null schema. If a null schema type is not
-// detected, an error will be returned as multi-types are not supported
-func getMultiTypeSchema(proxyOne *base.SchemaProxy, proxyTwo *base.SchemaProxy) (*base.Schema, *SchemaError) {
-	firstSchema, err := buildSchemaProxy(proxyOne)
-	if err != nil {
-		return nil, err
-	}
+// buildSchemaProxies builds each of the oneOf/anyOf subschemas, so they are only built once when checked for an object union
+// or a multi-type.
+func buildSchemaProxies(proxies []*base.SchemaProxy) ([]*base.Schema, *SchemaError) {
+	result := make([]*base.Schema, 0, len(proxies))
 
-	secondSchema, err := buildSchemaProxy(proxyTwo)
-	if err != nil {
-		return nil, err
+	for _, proxy := range proxies {
+		schema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, schema)
 	}
 
+	return result, nil
+}
+
+// getMultiTypeSchema will check the types of both schemas provided and will return the non-null schema. If a null schema type is not
+// detected, an error will be returned as multi-types are not supported
+func getMultiTypeSchema(firstSchema *base.Schema, secondSchema *base.Schema) (*base.Schema, *SchemaError) {
 	firstType, err := retrieveType(firstSchema)
 	if err != nil {
 		return nil, err
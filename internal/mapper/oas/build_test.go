@@ -11,6 +11,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/oas"
 
 	"github.com/google/go-cmp/cmp"
@@ -18,6 +19,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestBuildSchemaFromRequest(t *testing.T) {
@@ -1065,6 +1067,529 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 				},
 			},
 		},
+		"allOf with multiple elements - merge properties and required": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Description: "hey there! I'm the parent description.",
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"string_prop"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"string_prop": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm a string type, required.",
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"bool_prop"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"bool_prop": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"boolean"},
+								Description: "hey there! I'm a bool type, required.",
+							}),
+							"number_prop": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"number"},
+								Description: "hey there! I'm a number type.",
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_prop",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a bool type, required."),
+					},
+				},
+				&attrmapper.ResourceNumberAttribute{
+					Name: "number_prop",
+					NumberAttribute: resource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a number type."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a string type, required."),
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - deep merge nested properties and validators": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"parent_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				}),
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"nested_object": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"object"},
+								Description: "hey there! I'm an object type.",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"string_prop": base.CreateSchemaProxy(&base.Schema{
+										Type:      []string{"string"},
+										MinLength: pointer(int64(1)),
+										MaxLength: pointer(int64(100)),
+									}),
+								}),
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"nested_object": base.CreateSchemaProxy(&base.Schema{
+								Type:     []string{"object"},
+								Required: []string{"string_prop"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"string_prop": base.CreateSchemaProxy(&base.Schema{
+										MinLength: pointer(int64(5)),
+										MaxLength: pointer(int64(200)),
+									}),
+								}),
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_object",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "string_prop",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Validators: []schema.StringValidator{
+									{
+										Custom: frameworkvalidators.StringValidatorLengthBetween(5, 100),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm an object type."),
+					},
+				},
+				&attrmapper.ResourceBoolAttribute{
+					Name: "parent_prop",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - enum intersection compares value types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop": base.CreateSchemaProxy(&base.Schema{
+						AllOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+								Enum: []*yaml.Node{
+									{Kind: yaml.ScalarNode, Tag: "!!str", Value: "1"},
+									{Kind: yaml.ScalarNode, Value: "two"},
+								},
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Enum: []*yaml.Node{
+									{Kind: yaml.ScalarNode, Value: "1"},
+									{Kind: yaml.ScalarNode, Tag: "!!str", Value: "two"},
+								},
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: []schema.StringValidator{
+							{
+								Custom: frameworkvalidators.StringValidatorOneOf([]string{"two"}),
+							},
+						},
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - merge extensions and matching patterns": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"password": base.CreateSchemaProxy(&base.Schema{
+						AllOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type:    []string{"string"},
+								Pattern: "^[a-z]+$",
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Pattern: "^[a-z]+$",
+								Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+									"x-terraform-sensitive": {Kind: yaml.ScalarNode, Value: "true"},
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "password",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Sensitive:                pointer(true),
+						Validators: []schema.StringValidator{
+							{
+								Custom: frameworkvalidators.StringValidatorRegexMatches("^[a-z]+$", ""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_AllOfMergedKeywords(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		expectedMultipleOf *float64
+		expectedNullable   *bool
+	}{
+		"multipleOf of the other value - larger value": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:       []string{"integer"},
+						MultipleOf: pointer(float64(4)),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						MultipleOf: pointer(float64(2)),
+					}),
+				},
+			}),
+			expectedMultipleOf: pointer(float64(4)),
+		},
+		"nullable in every schema - nullable": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						Nullable: pointer(true),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Nullable: pointer(true),
+					}),
+				},
+			}),
+			expectedNullable: pointer(true),
+		},
+		"nullable in one schema - not nullable": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						Nullable: pointer(true),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Nullable: pointer(false),
+					}),
+				},
+			}),
+			expectedNullable: pointer(false),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(schema.Schema.MultipleOf, testCase.expectedMultipleOf); diff != "" {
+				t.Errorf("unexpected multipleOf difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(schema.Schema.Nullable, testCase.expectedNullable); diff != "" {
+				t.Errorf("unexpected nullable difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_UnionSchemaComposition(t *testing.T) {
+	t.Parallel()
+
+	exactlyOneOfValidator := []schema.ObjectValidator{
+		{
+			Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"http_location", "s3_location"}),
+		},
+	}
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"oneOf with object subschemas - variant per subschema": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"destination"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"destination": base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I'm a union type.",
+						OneOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Title:    "http_location",
+								Type:     []string{"object"},
+								Required: []string{"url"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"url": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "s3_location",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bucket": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "destination",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "http_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "url",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.Required,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "s3_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bucket",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a union type."),
+					},
+				},
+			},
+		},
+		"oneOf with parent extensions - extensions preserved": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"destination": base.CreateSchemaProxy(&base.Schema{
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-terraform-sensitive": {Kind: yaml.ScalarNode, Value: "true"},
+						}),
+						OneOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "http_location",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"url": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "s3_location",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bucket": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "destination",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "http_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "url",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "s3_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bucket",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Sensitive:                pointer(true),
+					},
+				},
+			},
+		},
+		"anyOf with nullable object subschemas - variant per non-null subschema": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"destination": base.CreateSchemaProxy(&base.Schema{
+						AnyOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"null"},
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "http_location",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"url": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "s3_location",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bucket": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "destination",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "http_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "url",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "s3_location",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bucket",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Validators:               exactlyOneOfValidator,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1134,7 +1659,7 @@ func TestBuildSchema_Errors(t *testing.T) {
 			}),
 			expectedErrRegex: `\[object string\] - unsupported multi-type, attribute cannot be created`,
 		},
-		"too many allOf": {
+		"allOf with conflicting types": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
 				AllOf: []*base.SchemaProxy{
 					base.CreateSchemaProxy(&base.Schema{
@@ -1145,7 +1670,132 @@ func TestBuildSchema_Errors(t *testing.T) {
 					}),
 				},
 			}),
-			expectedErrRegex: `found 2 allOf subschema\(s\), schema composition is currently not supported`,
+			expectedErrRegex: `allOf subschema at index 1 - conflicting types \[null\] and \[string\]`,
+		},
+		"allOf with conflicting property formats": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"number_prop": base.CreateSchemaProxy(&base.Schema{
+								Type:   []string{"number"},
+								Format: "double",
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"number_prop": base.CreateSchemaProxy(&base.Schema{
+								Type:   []string{"number"},
+								Format: "float",
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschema at index 1 - conflicting formats 'double' and 'float'`,
+		},
+		"allOf with no common enum values": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "one"},
+						},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "two"},
+						},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschema at index 1 - enums have no values in common`,
+		},
+		"allOf with enum values of different types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Style: yaml.DoubleQuotedStyle, Value: "1"},
+						},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "1"},
+						},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschema at index 1 - enums have no values in common`,
+		},
+		"allOf with conflicting patterns": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"string"},
+						Pattern: "^[a-z]+$",
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"string"},
+						Pattern: "^[0-9]+$",
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschema at index 1 - conflicting patterns '\^\[a-z\]\+\$' and '\^\[0-9\]\+\$'`,
+		},
+		"allOf with conflicting multipleOf values": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:       []string{"integer"},
+						MultipleOf: pointer(float64(2)),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:       []string{"integer"},
+						MultipleOf: pointer(float64(3)),
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschema at index 1 - conflicting multipleOf values '2' and '3'`,
+		},
+		"oneOf object union with no variant name": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "http_location",
+						Type:  []string{"object"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+				},
+			}),
+			expectedErrRegex: `unable to determine a name for union subschema at index 1, a discriminator mapping, \$ref, or title is required`,
+		},
+		"oneOf with invalid subschema": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "http_location",
+						Type:  []string{"object"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "s3_location",
+						Type:  []string{"object"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "no_type",
+					}),
+				},
+			}),
+			expectedErrRegex: `no 'type' array or supported allOf, oneOf, anyOf constraint - attribute cannot be created`,
 		},
 		"too many anyOf": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
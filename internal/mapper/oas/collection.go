@@ -22,6 +22,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -45,9 +46,18 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsSensitive(),
 				},
 			}
 
+			if defaultValue := s.GetSetDefault(name); defaultValue != nil {
+				if computability == schema.Required {
+					result.ComputedOptionalRequired = schema.ComputedOptional
+				}
+
+				result.Default = defaultValue
+			}
+
 			if computability != schema.Computed {
 				result.Validators = s.GetSetValidators()
 			}
@@ -64,9 +74,18 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
+		if defaultValue := s.GetListDefault(name); defaultValue != nil {
+			if computability == schema.Required {
+				result.ComputedOptionalRequired = schema.ComputedOptional
+			}
+
+			result.Default = defaultValue
+		}
+
 		if computability != schema.Computed {
 			result.Validators = s.GetListValidators()
 		}
@@ -87,9 +106,18 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
+		if defaultValue := s.GetSetDefault(name); defaultValue != nil {
+			if computability == schema.Required {
+				result.ComputedOptionalRequired = schema.ComputedOptional
+			}
+
+			result.Default = defaultValue
+		}
+
 		if computability != schema.Computed {
 			result.Validators = s.GetSetValidators()
 		}
@@ -104,9 +132,18 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
+	if defaultValue := s.GetListDefault(name); defaultValue != nil {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = defaultValue
+	}
+
 	if computability != schema.Computed {
 		result.Validators = s.GetListValidators()
 	}
@@ -121,6 +158,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -145,6 +183,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsSensitive(),
 				},
 			}
 
@@ -164,6 +203,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -188,6 +228,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -205,6 +246,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -222,6 +264,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -246,6 +289,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 					OptionalRequired:   optionalOrRequired,
 					DeprecationMessage: s.GetDeprecationMessage(),
 					Description:        s.GetDescription(),
+					Sensitive:          s.IsSensitive(),
 					Validators:         s.GetSetValidators(),
 				},
 			}
@@ -262,6 +306,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetListValidators(),
 			},
 		}
@@ -282,6 +327,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetSetValidators(),
 			},
 		}
@@ -296,6 +342,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetListValidators(),
 		},
 	}
@@ -310,6 +357,7 @@ func (s *OASSchema) BuildCollectionElementType() (schema.ElementType, *SchemaErr
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -362,6 +410,14 @@ func (s *OASSchema) GetListValidators() []schema.ListValidator {
 		})
 	}
 
+	if s.Schema.Items != nil && s.Schema.Items.IsA() {
+		if customValidator := s.getElementValidator(s.Schema.Items.A, listValuesAreFuncs); customValidator != nil {
+			result = append(result, schema.ListValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
 	return result
 }
 
@@ -385,5 +441,13 @@ func (s *OASSchema) GetSetValidators() []schema.SetValidator {
 		})
 	}
 
+	if s.Schema.Items != nil && s.Schema.Items.IsA() {
+		if customValidator := s.getElementValidator(s.Schema.Items.A, setValuesAreFuncs); customValidator != nil {
+			result = append(result, schema.SetValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
 	return result
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // TODO: add error tests
@@ -2023,6 +2024,50 @@ func TestGetListValidators(t *testing.T) {
 			},
 			expected: nil,
 		},
+		"items-enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type: []string{"string"},
+							Enum: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Value: "one"},
+								{Kind: yaml.ScalarNode, Value: "two"},
+							},
+						}),
+					},
+				},
+			},
+			expected: []schema.ListValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.OneOf(\n\"one\",\n\"two\",\n),\n)",
+					},
+				},
+			},
+		},
+		"items-without-validators": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type: []string{"string"},
+						}),
+					},
+				},
+			},
+			expected: nil,
+		},
 		"maxItems": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
@@ -2136,6 +2181,39 @@ func TestGetSetValidators(t *testing.T) {
 			},
 			expected: nil,
 		},
+		"items-enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:   []string{"array"},
+					Format: "set",
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:   []string{"integer"},
+							Format: "int32",
+							Enum: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Value: "80"},
+								{Kind: yaml.ScalarNode, Value: "443"},
+							},
+						}),
+					},
+				},
+			},
+			expected: []schema.SetValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+							},
+						},
+						SchemaDefinition: "setvalidator.ValueInt32sAre(\nint32validator.OneOf(\n80,\n443,\n),\n)",
+					},
+				},
+			},
+		},
 		"maxItems": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
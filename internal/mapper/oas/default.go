@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// frameworkCodeImportBasePath is the base code import path for the framework module.
+	frameworkCodeImportBasePath = "github.com/hashicorp/terraform-plugin-framework"
+
+	listDefaultPackage   = "listdefault"
+	mapDefaultPackage    = "mapdefault"
+	numberDefaultPackage = "numberdefault"
+	objectDefaultPackage = "objectdefault"
+	setDefaultPackage    = "setdefault"
+)
+
+var (
+	attrCodeImport  = code.Import{Path: frameworkCodeImportBasePath + "/attr"}
+	typesCodeImport = code.Import{Path: frameworkCodeImportBasePath + "/types"}
+	bigCodeImport   = code.Import{Path: "math/big"}
+)
+
+// valueType is the framework type of a static default value, i.e. `types.ListType{ElemType: types.StringType}`, built from an
+// OAS schema the same way as the attribute or element type.
+type valueType struct {
+	kind string
+
+	// elem is the element type of a list, map, or set
+	elem *valueType
+
+	// attrs are the attribute types of an object, sorted by name
+	attrs []valueAttribute
+}
+
+type valueAttribute struct {
+	name    string
+	oasName string
+	typ     *valueType
+}
+
+const (
+	valueKindBool    = "Bool"
+	valueKindFloat64 = "Float64"
+	valueKindInt32   = "Int32"
+	valueKindInt64   = "Int64"
+	valueKindList    = "List"
+	valueKindMap     = "Map"
+	valueKindNumber  = "Number"
+	valueKindObject  = "Object"
+	valueKindSet     = "Set"
+	valueKindString  = "String"
+)
+
+// GetListDefault returns a custom default with a static list value, i.e. `default: ["a", "b"]`, for a list or list nested attribute.
+// Returns nil if the schema has no default, or logs a warning if the default can't be represented as a list value.
+func (s *OASSchema) GetListDefault(name string) *schema.ListDefault {
+	customDefault := s.buildStaticValueDefault(name, listDefaultPackage)
+	if customDefault == nil {
+		return nil
+	}
+
+	return &schema.ListDefault{
+		Custom: customDefault,
+	}
+}
+
+// GetSetDefault returns a custom default with a static set value, i.e. `default: ["a", "b"]`, for a set or set nested attribute.
+// Returns nil if the schema has no default, or logs a warning if the default can't be represented as a set value.
+func (s *OASSchema) GetSetDefault(name string) *schema.SetDefault {
+	customDefault := s.buildStaticValueDefault(name, setDefaultPackage)
+	if customDefault == nil {
+		return nil
+	}
+
+	return &schema.SetDefault{
+		Custom: customDefault,
+	}
+}
+
+// GetMapDefault returns a custom default with a static map value, i.e. `default: {}`, for a map or map nested attribute. Returns nil
+// if the schema has no default, or logs a warning if the default can't be represented as a map value.
+func (s *OASSchema) GetMapDefault(name string) *schema.MapDefault {
+	customDefault := s.buildStaticValueDefault(name, mapDefaultPackage)
+	if customDefault == nil {
+		return nil
+	}
+
+	return &schema.MapDefault{
+		Custom: customDefault,
+	}
+}
+
+// GetObjectDefault returns a custom default with a static object value, i.e. `default: {enabled: true}`, for a single nested attribute.
+// Any property that isn't in the default is null. Returns nil if the schema has no default, or logs a warning if the default can't be
+// represented as an object value.
+func (s *OASSchema) GetObjectDefault(name string) *schema.ObjectDefault {
+	customDefault := s.buildStaticValueDefault(name, objectDefaultPackage)
+	if customDefault == nil {
+		return nil
+	}
+
+	return &schema.ObjectDefault{
+		Custom: customDefault,
+	}
+}
+
+// GetNumberDefault returns a custom default with a static *big.Float value, for a generic number attribute. Returns nil if the schema
+// has no default, or logs a warning if the default isn't a number.
+func (s *OASSchema) GetNumberDefault(name string) *schema.NumberDefault {
+	if s.Schema.Default == nil {
+		return nil
+	}
+
+	value, err := numberCode(s.Schema.Default)
+	if err != nil {
+		s.logUnsupportedDefault(name, err)
+		return nil
+	}
+
+	return &schema.NumberDefault{
+		Custom: &schema.CustomDefault{
+			Imports: []code.Import{
+				bigCodeImport,
+				frameworkDefaultCodeImport(numberDefaultPackage),
+			},
+			SchemaDefinition: fmt.Sprintf("%s.StaticBigFloat(%s)", numberDefaultPackage, value),
+		},
+	}
+}
+
+// decodeDefault decodes the default of a primitive schema, i.e. `string` or `int64`. Returns false if the schema has no default, or
+// logs a warning if the default can't be decoded to the attribute type.
+func decodeDefault[T any](s *OASSchema, name string) (T, bool) {
+	var value T
+
+	if s.Schema.Default == nil {
+		return value, false
+	}
+
+	value, err := decodeValue[T](s.Schema.Default)
+	if err != nil {
+		s.logUnsupportedDefault(name, err)
+		return value, false
+	}
+
+	return value, true
+}
+
+// decodeValue decodes a primitive value from a YAML node. Integers are checked to not lose any precision, as YAML decoding will
+// truncate a float to an integer, i.e. `1.5` to `1`.
+func decodeValue[T any](node *yaml.Node) (T, error) {
+	var value T
+
+	if err := node.Decode(&value); err != nil {
+		return value, fmt.Errorf("default must be %T: %w", value, err)
+	}
+
+	var integer int64
+	switch v := any(value).(type) {
+	case int32:
+		integer = int64(v)
+	case int64:
+		integer = v
+	default:
+		return value, nil
+	}
+
+	var float float64
+	if err := node.Decode(&float); err == nil && float != float64(integer) {
+		return value, fmt.Errorf("default must be %T, found %s", value, node.Value)
+	}
+
+	return value, nil
+}
+
+// logUnsupportedDefault logs a warning for a default that can't be represented in the attribute type, along with the line number
+// of the default in the OpenAPI spec.
+func (s *OASSchema) logUnsupportedDefault(name string, err error) {
+	logger := s.GlobalSchemaOpts.Logger
+	if logger == nil {
+		return
+	}
+
+	if s.Schema.Default.Line != 0 {
+		logger = logger.With("oas_line_number", s.Schema.Default.Line)
+	}
+
+	// Response schemas are usually mapped along with a request schema containing the same default, so the warning is only
+	// logged once for the request schema
+	if s.GlobalSchemaOpts.OverrideComputability == schema.Computed {
+		logger.Debug("skipping default, unable to represent default in attribute type", "attribute", name, "err", err)
+		return
+	}
+
+	logger.Warn("skipping default, unable to represent default in attribute type", "attribute", name, "err", err)
+}
+
+func (s *OASSchema) buildStaticValueDefault(name string, packageName string) *schema.CustomDefault {
+	if s.Schema.Default == nil {
+		return nil
+	}
+
+	typ, err := s.buildValueType(false)
+	if err != nil {
+		s.logUnsupportedDefault(name, err)
+		return nil
+	}
+
+	value, err := typ.valueCode(s.Schema.Default)
+	if err != nil {
+		s.logUnsupportedDefault(name, err)
+		return nil
+	}
+
+	imports := []code.Import{
+		attrCodeImport,
+		frameworkDefaultCodeImport(packageName),
+		typesCodeImport,
+	}
+	if strings.Contains(value, "big.NewFloat(") {
+		imports = append([]code.Import{bigCodeImport}, imports...)
+	}
+
+	return &schema.CustomDefault{
+		Imports:          imports,
+		SchemaDefinition: fmt.Sprintf("%s.StaticValue(%s)", packageName, value),
+	}
+}
+
+// buildValueType builds the framework type of the schema. Nested attributes and element types are mapped differently: an element
+// type is true when building an element type of a list, map, or set attribute, where object attribute types don't support Int32.
+func (s *OASSchema) buildValueType(elementType bool) (*valueType, error) {
+	switch s.Type {
+	case util.OAS_type_string:
+		return &valueType{kind: valueKindString}, nil
+	case util.OAS_type_boolean:
+		return &valueType{kind: valueKindBool}, nil
+	case util.OAS_type_integer:
+		if s.IsInt32() {
+			return &valueType{kind: valueKindInt32}, nil
+		}
+		return &valueType{kind: valueKindInt64}, nil
+	case util.OAS_type_number:
+		if s.Format == util.OAS_format_double || s.Format == util.OAS_format_float {
+			return &valueType{kind: valueKindFloat64}, nil
+		}
+		return &valueType{kind: valueKindNumber}, nil
+	case util.OAS_type_array:
+		if !s.Schema.Items.IsA() {
+			return nil, errors.New("invalid array items property, doesn't have a schema")
+		}
+
+		itemSchema, schemaErr := BuildSchema(s.Schema.Items.A, SchemaOpts{Ignores: s.SchemaOpts.Ignores, Aliases: s.SchemaOpts.Aliases}, s.GlobalSchemaOpts)
+		if schemaErr != nil {
+			return nil, schemaErr
+		}
+
+		// Matches the collection attributes, where a list of objects is a list nested attribute
+		nested := itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap()
+		elem, err := itemSchema.buildValueType(elementType || !nested)
+		if err != nil {
+			return nil, err
+		}
+
+		if s.Format == util.TF_format_set {
+			return &valueType{kind: valueKindSet, elem: elem}, nil
+		}
+		return &valueType{kind: valueKindList, elem: elem}, nil
+	case util.OAS_type_object:
+		if s.IsMap() {
+			mapSchema, schemaErr := BuildSchema(s.Schema.AdditionalProperties.A, SchemaOpts{Ignores: s.SchemaOpts.Ignores, Aliases: s.SchemaOpts.Aliases}, s.GlobalSchemaOpts)
+			if schemaErr != nil {
+				return nil, schemaErr
+			}
+
+			// Matches the map attributes, where a map of objects is a map nested attribute
+			nested := mapSchema.Type == util.OAS_type_object
+			elem, err := mapSchema.buildValueType(elementType || !nested)
+			if err != nil {
+				return nil, err
+			}
+
+			return &valueType{kind: valueKindMap, elem: elem}, nil
+		}
+
+		result := &valueType{kind: valueKindObject}
+
+		sortedProperties := orderedmap.SortAlpha(s.Schema.Properties)
+		for pair := range orderedmap.Iterate(context.TODO(), sortedProperties) {
+			name := pair.Key()
+
+			if s.IsPropertyIgnored(name) {
+				continue
+			}
+
+			schemaOpts := SchemaOpts{
+				Ignores: s.GetIgnoresForNested(name),
+				Aliases: s.GetAliasesForNested(name),
+			}
+
+			pSchema, schemaErr := BuildSchema(pair.Value(), schemaOpts, s.GlobalSchemaOpts)
+			if schemaErr != nil {
+				return nil, schemaErr
+			}
+
+			typ, err := pSchema.buildValueType(elementType)
+			if err != nil {
+				return nil, fmt.Errorf("property '%s': %w", name, err)
+			}
+
+			// Object attribute types do not support Int32, so the type is widened to Int64
+			if elementType && typ.kind == valueKindInt32 {
+				typ = &valueType{kind: valueKindInt64}
+			}
+
+			result.attrs = append(result.attrs, valueAttribute{
+				name:    util.TerraformIdentifier(s.GetAliasedName(name)),
+				oasName: name,
+				typ:     typ,
+			})
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type '%s'", s.Type)
+	}
+}
+
+// typeCode returns the framework type, i.e. `types.StringType`.
+func (t *valueType) typeCode() string {
+	switch t.kind {
+	case valueKindList, valueKindMap, valueKindSet:
+		return fmt.Sprintf("types.%sType{ElemType: %s}", t.kind, t.elem.typeCode())
+	case valueKindObject:
+		return fmt.Sprintf("types.ObjectType{AttrTypes: %s}", t.attrTypesCode())
+	default:
+		return fmt.Sprintf("types.%sType", t.kind)
+	}
+}
+
+func (t *valueType) attrTypesCode() string {
+	attrTypes := make([]string, 0, len(t.attrs))
+	for _, attr := range t.attrs {
+		attrTypes = append(attrTypes, fmt.Sprintf("%s: %s", strconv.Quote(attr.name), attr.typ.typeCode()))
+	}
+
+	return fmt.Sprintf("map[string]attr.Type{%s}", strings.Join(attrTypes, ", "))
+}
+
+// nullCode returns a null value of the framework type, i.e. `types.StringNull()`.
+func (t *valueType) nullCode() string {
+	switch t.kind {
+	case valueKindList, valueKindMap, valueKindSet:
+		return fmt.Sprintf("types.%sNull(%s)", t.kind, t.elem.typeCode())
+	case valueKindObject:
+		return fmt.Sprintf("types.ObjectNull(%s)", t.attrTypesCode())
+	default:
+		return fmt.Sprintf("types.%sNull()", t.kind)
+	}
+}
+
+// valueCode returns a value of the framework type from a default value in an OpenAPI spec, i.e. `types.StringValue("a")`.
+func (t *valueType) valueCode(node *yaml.Node) (string, error) {
+	if node.ShortTag() == "!!null" {
+		return t.nullCode(), nil
+	}
+
+	switch t.kind {
+	case valueKindBool:
+		value, err := decodeValue[bool](node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.BoolValue(%t)", value), nil
+	case valueKindFloat64:
+		value, err := decodeValue[float64](node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.Float64Value(%s)", strconv.FormatFloat(value, 'g', -1, 64)), nil
+	case valueKindInt32:
+		value, err := decodeValue[int32](node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.Int32Value(%d)", value), nil
+	case valueKindInt64:
+		value, err := decodeValue[int64](node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.Int64Value(%d)", value), nil
+	case valueKindNumber:
+		value, err := numberCode(node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.NumberValue(%s)", value), nil
+	case valueKindString:
+		value, err := decodeValue[string](node)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.StringValue(%s)", strconv.Quote(value)), nil
+	case valueKindList, valueKindSet:
+		if node.Kind != yaml.SequenceNode {
+			return "", fmt.Errorf("default must be an array, found %s", node.ShortTag())
+		}
+
+		elems := make([]string, 0, len(node.Content))
+		for _, elemNode := range node.Content {
+			elem, err := t.elem.valueCode(elemNode)
+			if err != nil {
+				return "", err
+			}
+			elems = append(elems, elem)
+		}
+
+		return fmt.Sprintf("types.%sValueMust(%s, []attr.Value{%s})", t.kind, t.elem.typeCode(), strings.Join(elems, ", ")), nil
+	case valueKindMap:
+		values, err := mappingValues(node)
+		if err != nil {
+			return "", err
+		}
+
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		elems := make([]string, 0, len(keys))
+		for _, key := range keys {
+			elem, err := t.elem.valueCode(values[key])
+			if err != nil {
+				return "", fmt.Errorf("key '%s': %w", key, err)
+			}
+			elems = append(elems, fmt.Sprintf("%s: %s", strconv.Quote(key), elem))
+		}
+
+		return fmt.Sprintf("types.MapValueMust(%s, map[string]attr.Value{%s})", t.elem.typeCode(), strings.Join(elems, ", ")), nil
+	case valueKindObject:
+		values, err := mappingValues(node)
+		if err != nil {
+			return "", err
+		}
+
+		attrValues := make([]string, 0, len(t.attrs))
+		for _, attr := range t.attrs {
+			value := attr.typ.nullCode()
+
+			if valueNode, ok := values[attr.oasName]; ok {
+				value, err = attr.typ.valueCode(valueNode)
+				if err != nil {
+					return "", fmt.Errorf("property '%s': %w", attr.oasName, err)
+				}
+				delete(values, attr.oasName)
+			}
+
+			attrValues = append(attrValues, fmt.Sprintf("%s: %s", strconv.Quote(attr.name), value))
+		}
+
+		if len(values) > 0 {
+			unknown := make([]string, 0, len(values))
+			for key := range values {
+				unknown = append(unknown, key)
+			}
+			sort.Strings(unknown)
+
+			return "", fmt.Errorf("property '%s' is not an attribute", unknown[0])
+		}
+
+		return fmt.Sprintf("types.ObjectValueMust(%s, map[string]attr.Value{%s})", t.attrTypesCode(), strings.Join(attrValues, ", ")), nil
+	default:
+		return "", fmt.Errorf("unsupported type '%s'", t.kind)
+	}
+}
+
+func numberCode(node *yaml.Node) (string, error) {
+	value, err := decodeValue[float64](node)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("big.NewFloat(%s)", strconv.FormatFloat(value, 'g', -1, 64)), nil
+}
+
+// mappingValues returns the values of a YAML mapping node by key.
+func mappingValues(node *yaml.Node) (map[string]*yaml.Node, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("default must be an object, found %s", node.ShortTag())
+	}
+
+	values := make(map[string]*yaml.Node, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		values[node.Content[i].Value] = node.Content[i+1]
+	}
+
+	return values, nil
+}
+
+func frameworkDefaultCodeImport(packageName string) code.Import {
+	return code.Import{
+		Path: frameworkCodeImportBasePath + "/resource/schema/" + packageName,
+	}
+}
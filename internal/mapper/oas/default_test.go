@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/oas"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func TestBuildResourceAttributes_Defaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema                *base.Schema
+		required              bool
+		overrideComputability schema.ComputedOptionalRequired
+		want                  any
+		wantWarns             []string
+	}{
+		"list of strings": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				Default: yamlNode(t, `["a", "b"]`),
+			},
+			want: &schema.ListDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("listdefault"),
+					SchemaDefinition: `listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}))`,
+				},
+			},
+		},
+		"list required": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				},
+				Default: yamlNode(t, `[]`),
+			},
+			required: true,
+			want: &schema.ListDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("listdefault"),
+					SchemaDefinition: `listdefault.StaticValue(types.ListValueMust(types.BoolType, []attr.Value{}))`,
+				},
+			},
+		},
+		"list nested": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"port": base.CreateSchemaProxy(&base.Schema{
+								Type:   []string{"integer"},
+								Format: "int32",
+							}),
+							"protocol": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+				Default: yamlNode(t, `[{port: 80}]`),
+			},
+			want: &schema.ListDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("listdefault"),
+					SchemaDefinition: `listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{"port": types.Int32Type, "protocol": types.StringType}}, []attr.Value{types.ObjectValueMust(map[string]attr.Type{"port": types.Int32Type, "protocol": types.StringType}, map[string]attr.Value{"port": types.Int32Value(80), "protocol": types.StringNull()})}))`,
+				},
+			},
+		},
+		"list of objects in a list": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"port": base.CreateSchemaProxy(&base.Schema{
+										Type:   []string{"integer"},
+										Format: "int32",
+									}),
+								}),
+							}),
+						},
+					}),
+				},
+				Default: yamlNode(t, `[[{port: 80}]]`),
+			},
+			// Object attribute types do not support Int32, so the element type is widened to Int64
+			want: &schema.ListDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("listdefault"),
+					SchemaDefinition: `listdefault.StaticValue(types.ListValueMust(types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"port": types.Int64Type}}}, []attr.Value{types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{"port": types.Int64Type}}, []attr.Value{types.ObjectValueMust(map[string]attr.Type{"port": types.Int64Type}, map[string]attr.Value{"port": types.Int64Value(80)})})}))`,
+				},
+			},
+		},
+		"set of numbers": {
+			schema: &base.Schema{
+				Type:   []string{"array"},
+				Format: "set",
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"number"},
+					}),
+				},
+				Default: yamlNode(t, `[1.5]`),
+			},
+			want: &schema.SetDefault{
+				Custom: &schema.CustomDefault{
+					Imports: append([]code.Import{
+						{Path: "math/big"},
+					}, staticValueImports("setdefault")...),
+					SchemaDefinition: `setdefault.StaticValue(types.SetValueMust(types.NumberType, []attr.Value{types.NumberValue(big.NewFloat(1.5))}))`,
+				},
+			},
+		},
+		"map of integers": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+				Default: yamlNode(t, `{b: 2, a: 1}`),
+			},
+			want: &schema.MapDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("mapdefault"),
+					SchemaDefinition: `mapdefault.StaticValue(types.MapValueMust(types.Int64Type, map[string]attr.Value{"a": types.Int64Value(1), "b": types.Int64Value(2)}))`,
+				},
+			},
+		},
+		"single nested": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"enabled": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+					"ratio": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"number"},
+						Format: "double",
+					}),
+				}),
+				Default: yamlNode(t, `{enabled: true, ratio: 0.5}`),
+			},
+			want: &schema.ObjectDefault{
+				Custom: &schema.CustomDefault{
+					Imports:          staticValueImports("objectdefault"),
+					SchemaDefinition: `objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{"enabled": types.BoolType, "ratio": types.Float64Type}, map[string]attr.Value{"enabled": types.BoolValue(true), "ratio": types.Float64Value(0.5)}))`,
+				},
+			},
+		},
+		"number": {
+			schema: &base.Schema{
+				Type:    []string{"number"},
+				Default: yamlNode(t, `12.5`),
+			},
+			want: &schema.NumberDefault{
+				Custom: &schema.CustomDefault{
+					Imports: []code.Import{
+						{Path: "math/big"},
+						{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/numberdefault"},
+					},
+					SchemaDefinition: `numberdefault.StaticBigFloat(big.NewFloat(12.5))`,
+				},
+			},
+		},
+		"list with invalid default": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				Default: yamlNode(t, `not-a-list`),
+			},
+			want:      (*schema.ListDefault)(nil),
+			wantWarns: []string{"default must be an array, found !!str"},
+		},
+		"list with invalid default in a response": {
+			schema: &base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				Default: yamlNode(t, `not-a-list`),
+			},
+			overrideComputability: schema.Computed,
+			want:                  (*schema.ListDefault)(nil),
+		},
+		"single nested with unknown property": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"enabled": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				}),
+				Default: yamlNode(t, `{enabled: true, unknown: 1}`),
+			},
+			want:      (*schema.ObjectDefault)(nil),
+			wantWarns: []string{"property 'unknown' is not an attribute"},
+		},
+		"int64 with invalid default": {
+			schema: &base.Schema{
+				Type:    []string{"integer"},
+				Default: yamlNode(t, `1.5`),
+			},
+			want:      (*schema.Int64Default)(nil),
+			wantWarns: []string{"default must be int64"},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+
+			objectSchema := &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"prop": base.CreateSchemaProxy(testCase.schema),
+				}),
+			}
+			if testCase.required {
+				objectSchema.Required = []string{"prop"}
+			}
+
+			s := oas.OASSchema{
+				Schema: objectSchema,
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					OverrideComputability: testCase.overrideComputability,
+					Logger:                slog.New(slog.NewTextHandler(&logs, nil)),
+				},
+			}
+			attributes, err := s.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(attributes) != 1 {
+				t.Fatalf("expected one attribute, got: %d", len(attributes))
+			}
+
+			got, computability := resourceAttributeDefault(t, attributes[0])
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Required attributes with a default are changed to computed and optional
+			if testCase.required && computability != schema.ComputedOptional {
+				t.Errorf("expected computed_optional, got: %s", computability)
+			}
+
+			for _, wantWarn := range testCase.wantWarns {
+				if !strings.Contains(logs.String(), wantWarn) {
+					t.Errorf("expected warning %q, got: %s", wantWarn, logs.String())
+				}
+			}
+
+			if len(testCase.wantWarns) == 0 && logs.Len() > 0 {
+				t.Errorf("unexpected warnings: %s", logs.String())
+			}
+		})
+	}
+}
+
+func resourceAttributeDefault(t *testing.T, attribute attrmapper.ResourceAttribute) (any, schema.ComputedOptionalRequired) {
+	t.Helper()
+
+	switch a := attribute.(type) {
+	case *attrmapper.ResourceInt64Attribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceListAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceListNestedAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceMapAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceNumberAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceSetAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	case *attrmapper.ResourceSingleNestedAttribute:
+		return a.Default, a.ComputedOptionalRequired
+	default:
+		t.Fatalf("unexpected attribute type: %T", attribute)
+		return nil, ""
+	}
+}
+
+func staticValueImports(packageName string) []code.Import {
+	return []code.Import{
+		{Path: "github.com/hashicorp/terraform-plugin-framework/attr"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/" + packageName},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+	}
+}
+
+func yamlNode(t *testing.T, value string) *yaml.Node {
+	t.Helper()
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &node); err != nil {
+		t.Fatalf("unexpected error unmarshaling YAML: %s", err)
+	}
+
+	return node.Content[0]
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// valuesAreFuncs are the framework validator functions that validate each element of a list, map, or set, by element type.
+type valuesAreFuncs struct {
+	strings  func([]*schema.CustomValidator) *schema.CustomValidator
+	int32s   func([]*schema.CustomValidator) *schema.CustomValidator
+	int64s   func([]*schema.CustomValidator) *schema.CustomValidator
+	float64s func([]*schema.CustomValidator) *schema.CustomValidator
+	numbers  func([]*schema.CustomValidator) *schema.CustomValidator
+}
+
+var (
+	listValuesAreFuncs = valuesAreFuncs{
+		strings:  frameworkvalidators.ListValidatorValueStringsAre,
+		int32s:   frameworkvalidators.ListValidatorValueInt32sAre,
+		int64s:   frameworkvalidators.ListValidatorValueInt64sAre,
+		float64s: frameworkvalidators.ListValidatorValueFloat64sAre,
+		numbers:  frameworkvalidators.ListValidatorValueNumbersAre,
+	}
+	mapValuesAreFuncs = valuesAreFuncs{
+		strings:  frameworkvalidators.MapValidatorValueStringsAre,
+		int32s:   frameworkvalidators.MapValidatorValueInt32sAre,
+		int64s:   frameworkvalidators.MapValidatorValueInt64sAre,
+		float64s: frameworkvalidators.MapValidatorValueFloat64sAre,
+		numbers:  frameworkvalidators.MapValidatorValueNumbersAre,
+	}
+	setValuesAreFuncs = valuesAreFuncs{
+		strings:  frameworkvalidators.SetValidatorValueStringsAre,
+		int32s:   frameworkvalidators.SetValidatorValueInt32sAre,
+		int64s:   frameworkvalidators.SetValidatorValueInt64sAre,
+		float64s: frameworkvalidators.SetValidatorValueFloat64sAre,
+		numbers:  frameworkvalidators.SetValidatorValueNumbersAre,
+	}
+)
+
+// getElementValidator returns a custom validator that validates each element of a list, map, or set with the validators of the
+// element schema, i.e. `listvalidator.ValueStringsAre(stringvalidator.OneOf(...))` for an array with string `enum` items. Returns nil
+// if the element schema has no validators, or the element type doesn't support element validators, such as objects.
+func (s *OASSchema) getElementValidator(elemProxy *base.SchemaProxy, funcs valuesAreFuncs) *schema.CustomValidator {
+	if elemProxy == nil {
+		return nil
+	}
+
+	elemSchema, err := BuildSchema(elemProxy, SchemaOpts{}, s.GlobalSchemaOpts)
+	if err != nil {
+		return nil
+	}
+
+	var validators []*schema.CustomValidator
+
+	switch elemSchema.Type {
+	case util.OAS_type_string:
+		for _, validator := range elemSchema.GetStringValidators() {
+			validators = append(validators, validator.Custom)
+		}
+
+		return funcs.strings(validators)
+	case util.OAS_type_integer:
+		if elemSchema.IsInt32() {
+			for _, validator := range elemSchema.GetInt32Validators() {
+				validators = append(validators, validator.Custom)
+			}
+
+			return funcs.int32s(validators)
+		}
+
+		for _, validator := range elemSchema.GetIntegerValidators() {
+			validators = append(validators, validator.Custom)
+		}
+
+		return funcs.int64s(validators)
+	case util.OAS_type_number:
+		if elemSchema.Format == util.OAS_format_double || elemSchema.Format == util.OAS_format_float {
+			for _, validator := range elemSchema.GetFloatValidators() {
+				validators = append(validators, validator.Custom)
+			}
+
+			return funcs.float64s(validators)
+		}
+
+		for _, validator := range elemSchema.GetNumberValidators() {
+			validators = append(validators, validator.Custom)
+		}
+
+		return funcs.numbers(validators)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// getEnumValues returns the allowed values of the schema, either the `const` value or the `enum` values. Null values are skipped,
+// as nullability isn't validated.
+func (s *OASSchema) getEnumValues() []*yaml.Node {
+	enum := s.Schema.Enum
+	if s.Schema.Const != nil {
+		enum = []*yaml.Node{s.Schema.Const}
+	}
+
+	var result []*yaml.Node
+	for _, valueNode := range enum {
+		if valueNode == nil || valueNode.ShortTag() == "!!null" {
+			continue
+		}
+
+		result = append(result, valueNode)
+	}
+
+	return result
+}
+
+// decodeEnumValues decodes the allowed values of the schema, skipping any value that can't be decoded to the attribute type.
+func decodeEnumValues[T any](s *OASSchema) []T {
+	var result []T
+
+	for _, valueNode := range s.getEnumValues() {
+		value, err := decodeValue[T](valueNode)
+		if err != nil {
+			// could consider error/panic here to notify developers
+			continue
+		}
+
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"strconv"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+const (
+	// IgnoreExtension can be set to true on a property schema to ignore the property during mapping, the same as an ignore in
+	// the generator config.
+	IgnoreExtension = "x-terraform-ignore"
+
+	// SensitiveExtension can be set to true on a schema to map it to a sensitive attribute.
+	SensitiveExtension = "x-terraform-sensitive"
+)
+
+// schemaExtensionBool returns true if the extension is set to a boolean true value on the schema.
+func schemaExtensionBool(schema *base.Schema, extension string) bool {
+	if schema == nil || schema.Extensions == nil {
+		return false
+	}
+
+	node, ok := schema.Extensions.Get(extension)
+	if !ok || node == nil {
+		return false
+	}
+
+	value, err := strconv.ParseBool(node.Value)
+	if err != nil {
+		return false
+	}
+
+	return value
+}
+
+// propertyExtensionBool returns true if the extension is set to a boolean true value on the property schema.
+func (s *OASSchema) propertyExtensionBool(name string, extension string) bool {
+	if s.Schema == nil || s.Schema.Properties == nil {
+		return false
+	}
+
+	pProxy, ok := s.Schema.Properties.Get(name)
+	if !ok || pProxy == nil {
+		return false
+	}
+
+	return schemaExtensionBool(pProxy.Schema(), extension)
+}
@@ -4,8 +4,11 @@
 package oas
 
 import (
+	"math"
+
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
 	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
@@ -13,42 +16,77 @@ import (
 )
 
 func (s *OASSchema) BuildIntegerResource(name string, computability schema.ComputedOptionalRequired) (attrmapper.ResourceAttribute, *SchemaError) {
+	if s.IsInt32() {
+		return s.buildInt32Resource(name, computability)
+	}
+
+	result := &attrmapper.ResourceInt64Attribute{
+		Name: name,
+		Int64Attribute: resource.Int64Attribute{
+			ComputedOptionalRequired: computability,
+			DeprecationMessage:       s.GetDeprecationMessage(),
+			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
+		},
+	}
+
+	if staticDefault, ok := decodeDefault[int64](s, name); ok {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = &schema.Int64Default{
+			Static: &staticDefault,
+		}
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetIntegerValidators()
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) buildInt32Resource(name string, computability schema.ComputedOptionalRequired) (attrmapper.ResourceAttribute, *SchemaError) {
 	result := &attrmapper.ResourceInt32Attribute{
 		Name: name,
 		Int32Attribute: resource.Int32Attribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
-	if s.Schema.Default != nil {
-		var staticDefault int32
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
-			if computability == schema.Required {
-				result.ComputedOptionalRequired = schema.ComputedOptional
-			}
+	if staticDefault, ok := decodeDefault[int32](s, name); ok {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
 
-			result.Default = &schema.Int32Default{
-				Static: &staticDefault,
-			}
+		result.Default = &schema.Int32Default{
+			Static: &staticDefault,
 		}
 	}
 
 	if computability != schema.Computed {
-		result.Validators = s.GetIntegerValidators()
+		result.Validators = s.GetInt32Validators()
 	}
 
 	return result, nil
 }
 
 func (s *OASSchema) BuildIntegerDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
-	result := &attrmapper.DataSourceInt32Attribute{
+	if s.IsInt32() {
+		return s.buildInt32DataSource(name, computability)
+	}
+
+	result := &attrmapper.DataSourceInt64Attribute{
 		Name: name,
-		Int32Attribute: datasource.Int32Attribute{
+		Int64Attribute: datasource.Int64Attribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -60,13 +98,51 @@ func (s *OASSchema) BuildIntegerDataSource(name string, computability schema.Com
 }
 
 func (s *OASSchema) BuildIntegerProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
+	if s.IsInt32() {
+		return s.buildInt32Provider(name, optionalOrRequired)
+	}
+
+	result := &attrmapper.ProviderInt64Attribute{
+		Name: name,
+		Int64Attribute: provider.Int64Attribute{
+			OptionalRequired:   optionalOrRequired,
+			DeprecationMessage: s.GetDeprecationMessage(),
+			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetIntegerValidators(),
+		},
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) buildInt32DataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
+	result := &attrmapper.DataSourceInt32Attribute{
+		Name: name,
+		Int32Attribute: datasource.Int32Attribute{
+			ComputedOptionalRequired: computability,
+			DeprecationMessage:       s.GetDeprecationMessage(),
+			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
+		},
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetInt32Validators()
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) buildInt32Provider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
 	result := &attrmapper.ProviderInt32Attribute{
 		Name: name,
 		Int32Attribute: provider.Int32Attribute{
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
-			Validators:         s.GetIntegerValidators(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetInt32Validators(),
 		},
 	}
 
@@ -74,27 +150,65 @@ func (s *OASSchema) BuildIntegerProvider(name string, optionalOrRequired schema.
 }
 
 func (s *OASSchema) BuildIntegerElementType() (schema.ElementType, *SchemaError) {
+	if s.IsInt32() {
+		return schema.ElementType{
+			Int32: &schema.Int32Type{},
+		}, nil
+	}
+
 	return schema.ElementType{
-		Int32: &schema.Int32Type{},
+		Int64: &schema.Int64Type{},
 	}, nil
 }
 
-func (s *OASSchema) GetIntegerValidators() []schema.Int32Validator {
-	var result []schema.Int32Validator
+// IsInt32 checks the format of an integer schema to determine if an Int32 attribute should be used, rather than Int64. Integer schemas
+// with no format will use the GlobalSchemaOpts.DefaultIntegerFormat, which defaults to "int64" if not populated.
+func (s *OASSchema) IsInt32() bool {
+	format := s.Format
+	if format == "" {
+		format = s.GlobalSchemaOpts.DefaultIntegerFormat
+	}
 
-	if len(s.Schema.Enum) > 0 {
-		var enum []int32
+	return format == util.OAS_format_int32
+}
 
-		for _, valueNode := range s.Schema.Enum {
-			var value int32
-			if err := valueNode.Decode(&value); err != nil {
-				// could consider error/panic here to notify developers
-				continue
-			}
+func (s *OASSchema) GetIntegerValidators() []schema.Int64Validator {
+	var result []schema.Int64Validator
 
-			enum = append(enum, value)
+	if enum := decodeEnumValues[int64](s); len(enum) > 0 {
+		customValidator := frameworkvalidators.Int64ValidatorOneOf(enum)
+
+		if customValidator != nil {
+			result = append(result, schema.Int64Validator{
+				Custom: customValidator,
+			})
 		}
+	}
+
+	minimum := s.Schema.Minimum
+	maximum := s.Schema.Maximum
 
+	if minimum != nil && maximum != nil {
+		result = append(result, schema.Int64Validator{
+			Custom: frameworkvalidators.Int64ValidatorBetween(int64(*minimum), int64(*maximum)),
+		})
+	} else if minimum != nil {
+		result = append(result, schema.Int64Validator{
+			Custom: frameworkvalidators.Int64ValidatorAtLeast(int64(*minimum)),
+		})
+	} else if maximum != nil {
+		result = append(result, schema.Int64Validator{
+			Custom: frameworkvalidators.Int64ValidatorAtMost(int64(*maximum)),
+		})
+	}
+
+	return result
+}
+
+func (s *OASSchema) GetInt32Validators() []schema.Int32Validator {
+	var result []schema.Int32Validator
+
+	if enum := decodeEnumValues[int32](s); len(enum) > 0 {
 		customValidator := frameworkvalidators.Int32ValidatorOneOf(enum)
 
 		if customValidator != nil {
@@ -109,17 +223,22 @@ func (s *OASSchema) GetIntegerValidators() []schema.Int32Validator {
 
 	if minimum != nil && maximum != nil {
 		result = append(result, schema.Int32Validator{
-			Custom: frameworkvalidators.Int32ValidatorBetween(int32(*minimum), int32(*maximum)),
+			Custom: frameworkvalidators.Int32ValidatorBetween(clampInt32(*minimum), clampInt32(*maximum)),
 		})
 	} else if minimum != nil {
 		result = append(result, schema.Int32Validator{
-			Custom: frameworkvalidators.Int32ValidatorAtLeast(int32(*minimum)),
+			Custom: frameworkvalidators.Int32ValidatorAtLeast(clampInt32(*minimum)),
 		})
 	} else if maximum != nil {
 		result = append(result, schema.Int32Validator{
-			Custom: frameworkvalidators.Int32ValidatorAtMost(int32(*maximum)),
+			Custom: frameworkvalidators.Int32ValidatorAtMost(clampInt32(*maximum)),
 		})
 	}
 
 	return result
 }
+
+// clampInt32 converts a minimum or maximum to an int32, clamping values outside of the int32 range, as converting them would overflow.
+func clampInt32(value float64) int32 {
+	return int32(max(math.MinInt32, min(value, math.MaxInt32)))
+}
@@ -26,8 +26,141 @@ func TestBuildIntegerResource(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema             *base.Schema
+		globalSchemaOpts   oas.GlobalSchemaOpts
 		expectedAttributes attrmapper.ResourceAttributes
 	}{
+		"int32 attributes": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"int32_prop_required"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int32_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type.",
+						Default:     &yaml.Node{Kind: yaml.ScalarNode, Value: "123"},
+					}),
+					"int32_prop_required": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type, required.",
+						Minimum:     pointer(float64(1)),
+						Maximum:     pointer(float64(10)),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int32_prop",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm an int32 type."),
+						Default: &schema.Int32Default{
+							Static: pointer(int32(123)),
+						},
+					},
+				},
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int32_prop_required",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm an int32 type, required."),
+						Validators: []schema.Int32Validator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+										},
+									},
+									SchemaDefinition: "int32validator.Between(1, 10)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"int64 format with bounds larger than int32": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int64_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"integer"},
+						Format:  "int64",
+						Minimum: pointer(float64(3000000000)),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "int64_prop",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: []schema.Int64Validator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+										},
+									},
+									SchemaDefinition: "int64validator.AtLeast(3000000000)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"default integer format int32": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int32_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+					"int64_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"integer"},
+						Format: "int64",
+					}),
+					"int32_list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+				}),
+			},
+			globalSchemaOpts: oas.GlobalSchemaOpts{
+				DefaultIntegerFormat: "int32",
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "int32_list_prop",
+					ListAttribute: resource.ListAttribute{
+						ElementType: schema.ElementType{
+							Int32: &schema.Int32Type{},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceInt32Attribute{
+					Name: "int32_prop",
+					Int32Attribute: resource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceInt64Attribute{
+					Name: "int64_prop",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 		"int64 attributes": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -220,7 +353,7 @@ func TestBuildIntegerResource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			schema := oas.OASSchema{Schema: testCase.schema}
+			schema := oas.OASSchema{Schema: testCase.schema, GlobalSchemaOpts: testCase.globalSchemaOpts}
 			attributes, err := schema.BuildResourceAttributes()
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -240,6 +373,40 @@ func TestBuildIntegerDataSource(t *testing.T) {
 		schema             *base.Schema
 		expectedAttributes attrmapper.DataSourceAttributes
 	}{
+		"int32 attributes": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"int32_prop_required"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int32_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type.",
+					}),
+					"int32_prop_required": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type, required.",
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceInt32Attribute{
+					Name: "int32_prop",
+					Int32Attribute: datasource.Int32Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm an int32 type."),
+					},
+				},
+				&attrmapper.DataSourceInt32Attribute{
+					Name: "int32_prop_required",
+					Int32Attribute: datasource.Int32Attribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm an int32 type, required."),
+					},
+				},
+			},
+		},
 		"int64 attributes": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -402,6 +569,40 @@ func TestBuildIntegerProvider(t *testing.T) {
 		schema             *base.Schema
 		expectedAttributes attrmapper.ProviderAttributes
 	}{
+		"int32 attributes": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"int32_prop_required"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"int32_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type.",
+					}),
+					"int32_prop_required": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Format:      "int32",
+						Description: "hey there! I'm an int32 type, required.",
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderInt32Attribute{
+					Name: "int32_prop",
+					Int32Attribute: provider.Int32Attribute{
+						OptionalRequired: schema.Optional,
+						Description:      pointer("hey there! I'm an int32 type."),
+					},
+				},
+				&attrmapper.ProviderInt32Attribute{
+					Name: "int32_prop_required",
+					Int32Attribute: provider.Int32Attribute{
+						OptionalRequired: schema.Required,
+						Description:      pointer("hey there! I'm an int32 type, required."),
+					},
+				},
+			},
+		},
 		"int64 attributes": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -671,3 +872,121 @@ func TestGetIntegerValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInt32Validators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   oas.OASSchema
+		expected []schema.Int32Validator
+	}{
+		"none": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:   []string{"integer"},
+					Format: "int32",
+				},
+			},
+			expected: nil,
+		},
+		"maximum-and-minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Minimum: pointer(float64(123.2)),
+					Maximum: pointer(float64(456.2)),
+				},
+			},
+			expected: []schema.Int32Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+							},
+						},
+						SchemaDefinition: "int32validator.Between(123, 456)",
+					},
+				},
+			},
+		},
+		"maximum-and-minimum-out-of-range": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Minimum: pointer(float64(-3000000000)),
+					Maximum: pointer(float64(3000000000)),
+				},
+			},
+			expected: []schema.Int32Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+							},
+						},
+						SchemaDefinition: "int32validator.Between(-2147483648, 2147483647)",
+					},
+				},
+			},
+		},
+		"maximum-out-of-range": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Maximum: pointer(float64(3000000000)),
+				},
+			},
+			expected: []schema.Int32Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+							},
+						},
+						SchemaDefinition: "int32validator.AtMost(2147483647)",
+					},
+				},
+			},
+		},
+		"minimum-out-of-range": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Minimum: pointer(float64(-3000000000)),
+				},
+			},
+			expected: []schema.Int32Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int32validator",
+							},
+						},
+						SchemaDefinition: "int32validator.AtLeast(-2147483648)",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetInt32Validators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
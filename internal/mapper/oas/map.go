@@ -24,6 +24,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -44,9 +45,18 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
+		if defaultValue := s.GetMapDefault(name); defaultValue != nil {
+			if computability == schema.Required {
+				result.ComputedOptionalRequired = schema.ComputedOptional
+			}
+
+			result.Default = defaultValue
+		}
+
 		if computability != schema.Computed {
 			result.Validators = s.GetMapValidators()
 		}
@@ -66,9 +76,18 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
+	if defaultValue := s.GetMapDefault(name); defaultValue != nil {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = defaultValue
+	}
+
 	if computability != schema.Computed {
 		result.Validators = s.GetMapValidators()
 	}
@@ -85,6 +104,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -106,6 +126,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -128,6 +149,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -147,6 +169,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -168,6 +191,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetMapValidators(),
 			},
 		}
@@ -187,6 +211,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetMapValidators(),
 		},
 	}
@@ -203,6 +228,7 @@ func (s *OASSchema) BuildMapElementType() (schema.ElementType, *SchemaError) {
 
 	schemaOpts := SchemaOpts{
 		Ignores: s.SchemaOpts.Ignores,
+		Aliases: s.SchemaOpts.Aliases,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -241,5 +267,13 @@ func (s *OASSchema) GetMapValidators() []schema.MapValidator {
 		})
 	}
 
+	if s.IsMap() {
+		if customValidator := s.getElementValidator(s.Schema.AdditionalProperties.A, mapValuesAreFuncs); customValidator != nil {
+			result = append(result, schema.MapValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
 	return result
 }
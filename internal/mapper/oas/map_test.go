@@ -14,6 +14,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/schema"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/oas"
@@ -809,6 +810,38 @@ func TestGetMapValidators(t *testing.T) {
 			},
 			expected: nil,
 		},
+		"additionalProperties-enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"object"},
+					AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:   []string{"number"},
+							Format: "double",
+							Enum: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Value: "0.5"},
+								{Kind: yaml.ScalarNode, Value: "1"},
+							},
+						}),
+					},
+				},
+			},
+			expected: []schema.MapValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "mapvalidator.ValueFloat64sAre(\nfloat64validator.OneOf(\n0.5,\n1,\n),\n)",
+					},
+				},
+			},
+		},
 		"maxProperties": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
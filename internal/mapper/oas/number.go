@@ -21,19 +21,17 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
-		if s.Schema.Default != nil {
-			var staticDefault float64
-			if err := s.Schema.Default.Decode(&staticDefault); err == nil {
-				if computability == schema.Required {
-					result.ComputedOptionalRequired = schema.ComputedOptional
-				}
+		if staticDefault, ok := decodeDefault[float64](s, name); ok {
+			if computability == schema.Required {
+				result.ComputedOptionalRequired = schema.ComputedOptional
+			}
 
-				result.Default = &schema.Float64Default{
-					Static: &staticDefault,
-				}
+			result.Default = &schema.Float64Default{
+				Static: &staticDefault,
 			}
 		}
 
@@ -44,14 +42,29 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 		return result, nil
 	}
 
-	return &attrmapper.ResourceNumberAttribute{
+	result := &attrmapper.ResourceNumberAttribute{
 		Name: name,
 		NumberAttribute: resource.NumberAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if defaultValue := s.GetNumberDefault(name); defaultValue != nil {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = defaultValue
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetNumberValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildNumberDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -62,6 +75,7 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -77,9 +91,14 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
+	if computability != schema.Computed {
+		result.Validators = s.GetNumberValidators()
+	}
+
 	return result, nil
 }
 
@@ -91,6 +110,7 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetFloatValidators(),
 			},
 		}
@@ -103,6 +123,8 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetNumberValidators(),
 		},
 	}
 
@@ -124,23 +146,27 @@ func (s *OASSchema) BuildNumberElementType() (schema.ElementType, *SchemaError)
 func (s *OASSchema) GetFloatValidators() []schema.Float64Validator {
 	var result []schema.Float64Validator
 
-	if len(s.Schema.Enum) > 0 {
-		var enum []float64
-
-		for _, valueNode := range s.Schema.Enum {
-			var value float64
-			if err := valueNode.Decode(&value); err != nil {
-				// could consider error/panic here to notify developers
-				continue
-			}
+	if enum := decodeEnumValues[float64](s); len(enum) > 0 {
+		customValidator := frameworkvalidators.Float64ValidatorOneOf(enum)
 
-			enum = append(enum, value)
+		if customValidator != nil {
+			result = append(result, schema.Float64Validator{
+				Custom: customValidator,
+			})
 		}
+	}
 
-		customValidator := frameworkvalidators.Float64ValidatorOneOf(enum)
+	return result
+}
+
+func (s *OASSchema) GetNumberValidators() []schema.NumberValidator {
+	var result []schema.NumberValidator
+
+	if enum := decodeEnumValues[float64](s); len(enum) > 0 {
+		customValidator := frameworkvalidators.NumberValidatorOneOf(enum)
 
 		if customValidator != nil {
-			result = append(result, schema.Float64Validator{
+			result = append(result, schema.NumberValidator{
 				Custom: customValidator,
 			})
 		}
@@ -1123,3 +1123,83 @@ func TestGetFloatValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNumberValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   oas.OASSchema
+		expected []schema.NumberValidator
+	}{
+		"none": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+				},
+			},
+			expected: nil,
+		},
+		"enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "1.2"},
+						{Kind: yaml.ScalarNode, Value: "3"},
+					},
+				},
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "math/big",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+							},
+						},
+						SchemaDefinition: "numbervalidator.OneOf(\nbig.NewFloat(1.2),\nbig.NewFloat(3),\n)",
+					},
+				},
+			},
+		},
+		"const": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:  []string{"number"},
+					Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "0.5"},
+				},
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "math/big",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+							},
+						},
+						SchemaDefinition: "numbervalidator.OneOf(\nbig.NewFloat(0.5),\n)",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetNumberValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
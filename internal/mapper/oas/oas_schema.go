@@ -5,6 +5,8 @@ package oas
 
 import (
 	"context"
+	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
@@ -31,6 +33,14 @@ type GlobalSchemaOpts struct {
 	// create request for a resource, does not become required from a lower precedence operation, such as an
 	// read response for a resource.
 	OverrideComputability schema.ComputedOptionalRequired
+
+	// DefaultIntegerFormat is the format used for integer schemas that have no format defined, either "int64" or "int32". If
+	// not populated, integer schemas with no format will default to "int64".
+	DefaultIntegerFormat string
+
+	// Logger is used to log warnings for schemas that are mapped, but not entirely, like a default that can't be represented in the
+	// attribute type. If not populated, no warnings are logged.
+	Logger *slog.Logger
 }
 
 // SchemaOpts is NOT passed recursively through built OASSchema structs, and will only be available to the top level schema. This is used
@@ -39,6 +49,10 @@ type SchemaOpts struct {
 	// Ignores contains all potentially relevant ignores for a schema and it's potential nested schemas
 	Ignores []string
 
+	// Aliases contains all potentially relevant aliases for a schema and it's potential nested schemas, with the key being a
+	// dot-separated property path and the value being the new attribute name (alias)
+	Aliases map[string]string
+
 	// OverrideDeprecationMessage will set the attribute deprecation message to
 	// this field if populated, otherwise the attribute deprecation message will
 	// be set to a default "This attribute is deprecated." message when the
@@ -48,6 +62,10 @@ type SchemaOpts struct {
 	// OverrideDescription will set the attribute description to this field if populated, otherwise the attribute description
 	// will be set to the description field of the `schema`.
 	OverrideDescription string
+
+	// ExactlyOneOf contains the names of all variants in a oneOf/anyOf object union, if this schema is one of those variants. This
+	// is used to create an exactly one of validator on the variant attribute.
+	ExactlyOneOf []string
 }
 
 // IsMap checks the `additionalProperties` field to determine if a map type is appropriate (refer to [JSON Schema - additionalProperties]).
@@ -69,7 +87,13 @@ func (s *OASSchema) NestSchemaError(err *SchemaError, propName string) *SchemaEr
 
 // getPropertyLineNumber looks in the low-level schema instance for line information. Returns 0 if not found.
 func (s *OASSchema) getPropertyLineNumber(propName string) int {
-	low := s.Schema.GoLow()
+	return getSchemaPropertyLineNumber(s.Schema, propName)
+}
+
+// getSchemaPropertyLineNumber looks in the low-level schema for the line number of a property, defaulting
+// to the line number of the parent node. Returns 0 if not found.
+func getSchemaPropertyLineNumber(schema *base.Schema, propName string) int {
+	low := schema.GoLow()
 	if low == nil {
 		return 0
 	}
@@ -119,7 +143,7 @@ func (s *OASSchema) GetDescription() *string {
 }
 
 func (s *OASSchema) IsSensitive() *bool {
-	isSensitive := s.Format == util.OAS_format_password
+	isSensitive := s.Format == util.OAS_format_password || schemaExtensionBool(s.Schema, SensitiveExtension)
 
 	if !isSensitive {
 		return nil
@@ -140,6 +164,11 @@ func (s *OASSchema) GetComputability(name string) schema.ComputedOptionalRequire
 		}
 	}
 
+	// Union variants are mutually exclusive, so they can't be computed
+	if s.IsUnionVariant(name) {
+		return schema.Optional
+	}
+
 	return schema.ComputedOptional
 }
 
@@ -153,28 +182,82 @@ func (s *OASSchema) GetOptionalOrRequired(name string) schema.OptionalRequired {
 	return schema.Optional
 }
 
-// IsPropertyIgnored checks if a property should be ignored
+// IsPropertyIgnored checks if a property should be ignored, either from the ignores in the generator config or the
+// `x-terraform-ignore` extension on the property schema.
 func (s *OASSchema) IsPropertyIgnored(name string) bool {
 	for _, ignore := range s.SchemaOpts.Ignores {
-		if name == ignore {
+		if util.MatchesAttributeName(strings.Split(ignore, "."), name) {
 			return true
 		}
 	}
+	return s.propertyExtensionBool(name, IgnoreExtension)
+}
+
+// HasPropertyPath checks if a dot-separated property path exists in the schema, which is used to detect ignores that don't
+// match any property. Arrays and maps are traversed through their items and additionalProperties schemas, matching how
+// ignores are passed to nested attributes. The property path can contain wildcards, i.e. `**.links`.
+func (s *OASSchema) HasPropertyPath(path string) bool {
+	return schemaHasPropertyPath(s.Schema, strings.Split(path, "."), map[string]bool{})
+}
+
+// schemaHasPropertyPath recursively searches for the property path. Referenced schemas that have already been searched with
+// the same property path are skipped, as a `**` wildcard would never stop searching a circular reference.
+func schemaHasPropertyPath(baseSchema *base.Schema, path []string, visited map[string]bool) bool {
+	if baseSchema == nil {
+		return false
+	}
+
+	if baseSchema.Items != nil && baseSchema.Items.IsA() && proxyHasPropertyPath(baseSchema.Items.A, path, visited) {
+		return true
+	}
+
+	if baseSchema.AdditionalProperties != nil && baseSchema.AdditionalProperties.IsA() && proxyHasPropertyPath(baseSchema.AdditionalProperties.A, path, visited) {
+		return true
+	}
+
+	if baseSchema.Properties == nil {
+		return false
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), baseSchema.Properties) {
+		for _, remaining := range util.MatchAttributeName(path, pair.Key()) {
+			if len(remaining) == 0 || proxyHasPropertyPath(pair.Value(), remaining, visited) {
+				return true
+			}
+		}
+	}
+
 	return false
 }
 
+func proxyHasPropertyPath(proxy *base.SchemaProxy, path []string, visited map[string]bool) bool {
+	if proxy.IsReference() {
+		key := proxy.GetReference() + "|" + strings.Join(path, ".")
+		if visited[key] {
+			return false
+		}
+		visited[key] = true
+	}
+
+	propSchema, err := buildSchemaProxy(proxy)
+	if err != nil {
+		return false
+	}
+
+	return schemaHasPropertyPath(propSchema, path, visited)
+}
+
 // GetIgnoresForNested is a helper function that will return all nested ignores for a property. If no ignores
-// or nested ignores are found, returns an empty string slice.
+// or nested ignores are found, returns an empty string slice. Ignores with wildcards are kept for nested properties
+// as long as they can still match, i.e. `**.links` applies to every nested property.
 func (s *OASSchema) GetIgnoresForNested(name string) []string {
 	newIgnores := make([]string, 0)
 
 	for _, ignore := range s.SchemaOpts.Ignores {
-		ignoreParts := strings.Split(ignore, ".")
-
-		if len(ignoreParts) > 1 && name == ignoreParts[0] {
-			newIgnore := strings.Join(ignoreParts[1:], ".")
+		for _, remaining := range util.MatchAttributeName(strings.Split(ignore, "."), name) {
+			newIgnore := strings.Join(remaining, ".")
 
-			if newIgnore != "" {
+			if newIgnore != "" && !slices.Contains(newIgnores, newIgnore) {
 				newIgnores = append(newIgnores, newIgnore)
 			}
 		}
@@ -182,3 +265,48 @@ func (s *OASSchema) GetIgnoresForNested(name string) []string {
 
 	return newIgnores
 }
+
+// GetAliasedName returns the attribute name for a property, which is either the alias from the generator config or the property
+// name itself. An alias for the exact property name takes precedence over aliases with wildcards, which are checked in sorted order.
+func (s *OASSchema) GetAliasedName(name string) string {
+	if alias, ok := s.SchemaOpts.Aliases[name]; ok {
+		return alias
+	}
+
+	for _, location := range util.SortedKeys(s.SchemaOpts.Aliases) {
+		if util.MatchesAttributeName(strings.Split(location, "."), name) {
+			return s.SchemaOpts.Aliases[location]
+		}
+	}
+
+	return name
+}
+
+// GetAliasesForNested is a helper function that will return all nested aliases for a property. If no nested aliases are found,
+// returns nil. Like ignores, aliases with wildcards are kept for nested properties as long as they can still match.
+func (s *OASSchema) GetAliasesForNested(name string) map[string]string {
+	var newAliases map[string]string
+
+	for _, location := range util.SortedKeys(s.SchemaOpts.Aliases) {
+		path := strings.Split(location, ".")
+		isPattern := util.IsAttributeLocationPattern(path)
+
+		for _, remaining := range util.MatchAttributeName(path, name) {
+			newLocation := strings.Join(remaining, ".")
+			if newLocation == "" {
+				continue
+			}
+
+			if newAliases == nil {
+				newAliases = map[string]string{}
+			}
+
+			// Aliases without wildcards take precedence, as they are the most specific
+			if _, ok := newAliases[newLocation]; !ok || !isPattern {
+				newAliases[newLocation] = s.SchemaOpts.Aliases[location]
+			}
+		}
+	}
+
+	return newAliases
+}
@@ -168,6 +168,39 @@ func TestIsPropertyIgnored(t *testing.T) {
 			},
 			want: false,
 		},
+		"propery is ignored by wildcard": {
+			propertyName: "etag",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"e*",
+					},
+				},
+			},
+			want: true,
+		},
+		"propery is ignored by any attributes wildcard": {
+			propertyName: "links",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"**.links",
+					},
+				},
+			},
+			want: true,
+		},
+		"propery is not ignored by nested wildcard": {
+			propertyName: "tags",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"tags.*",
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -232,6 +265,25 @@ func TestGetIgnoresForNested(t *testing.T) {
 				"ignore_me_3",
 			},
 		},
+		"nested ignores with wildcards": {
+			propertyName: "metadata",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"*.etag",
+						"**.links",
+						"meta*.labels.*",
+						"spec.*",
+						"metadata",
+					},
+				},
+			},
+			want: []string{
+				"etag",
+				"**.links",
+				"labels.*",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -246,3 +298,140 @@ func TestGetIgnoresForNested(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAliasedName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema       oas.OASSchema
+		propertyName string
+		want         string
+	}{
+		"no aliases": {
+			propertyName: "prop",
+			schema:       oas.OASSchema{},
+			want:         "prop",
+		},
+		"alias for property": {
+			propertyName: "containerPort",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"containerPort":      "port",
+						"spec.containerPort": "nested_port",
+					},
+				},
+			},
+			want: "port",
+		},
+		"alias for nested property only": {
+			propertyName: "containerPort",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"spec.containerPort": "port",
+					},
+				},
+			},
+			want: "containerPort",
+		},
+		"alias with wildcards": {
+			propertyName: "containerPort",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"**.containerPort": "port",
+					},
+				},
+			},
+			want: "port",
+		},
+		"alias without wildcards takes precedence": {
+			propertyName: "containerPort",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"*Port":         "any_port",
+						"containerPort": "port",
+					},
+				},
+			},
+			want: "port",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetAliasedName(testCase.propertyName)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAliasesForNested(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema       oas.OASSchema
+		propertyName string
+		want         map[string]string
+	}{
+		"no aliases": {
+			propertyName: "spec",
+			schema:       oas.OASSchema{},
+			want:         nil,
+		},
+		"nested aliases exist": {
+			propertyName: "spec",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"spec":                        "specification",
+						"spec.containerPort":          "port",
+						"spec.template.containerPort": "template_port",
+						"status.containerPort":        "status_port",
+						"specification.containerPort": "not_me",
+					},
+				},
+			},
+			want: map[string]string{
+				"containerPort":          "port",
+				"template.containerPort": "template_port",
+			},
+		},
+		"nested aliases with wildcards": {
+			propertyName: "spec",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"**.id":   "identifier",
+						"*.name":  "title",
+						"spec.id": "spec_id",
+					},
+				},
+			},
+			want: map[string]string{
+				"**.id": "identifier",
+				"id":    "spec_id",
+				"name":  "title",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetAliasesForNested(testCase.propertyName)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
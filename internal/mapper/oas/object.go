@@ -25,6 +25,7 @@ func (s *OASSchema) BuildObjectElementType() (schema.ElementType, *SchemaError)
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
 			Ignores: s.GetIgnoresForNested(name),
+			Aliases: s.GetAliasesForNested(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -37,7 +38,7 @@ func (s *OASSchema) BuildObjectElementType() (schema.ElementType, *SchemaError)
 			return schema.ElementType{}, s.NestSchemaError(err, name)
 		}
 
-		objectElemTypes = append(objectElemTypes, util.CreateObjectAttributeType(name, elemType))
+		objectElemTypes = append(objectElemTypes, util.CreateObjectAttributeType(s.GetAliasedName(name), elemType))
 	}
 
 	return schema.ElementType{
@@ -5,6 +5,7 @@ package oas
 
 import (
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
 	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
@@ -17,15 +18,30 @@ func (s *OASSchema) BuildSingleNestedResource(name string, computability schema.
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.ResourceSingleNestedAttribute{
+	result := &attrmapper.ResourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: resource.SingleNestedAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if defaultValue := s.GetObjectDefault(name); defaultValue != nil {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = defaultValue
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -34,15 +50,22 @@ func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schem
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.DataSourceSingleNestedAttribute{
+	result := &attrmapper.DataSourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: datasource.SingleNestedAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
@@ -58,6 +81,20 @@ func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired sc
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetObjectValidators(),
 		},
 	}, nil
 }
+
+func (s *OASSchema) GetObjectValidators() []schema.ObjectValidator {
+	var result []schema.ObjectValidator
+
+	if len(s.SchemaOpts.ExactlyOneOf) > 0 {
+		result = append(result, schema.ObjectValidator{
+			Custom: frameworkvalidators.ObjectValidatorExactlyOneOf(s.SchemaOpts.ExactlyOneOf),
+		})
+	}
+
+	return result
+}
@@ -23,16 +23,13 @@ func (s *OASSchema) BuildStringResource(name string, computability schema.Comput
 		},
 	}
 
-	if s.Schema.Default != nil {
-		var staticDefault string
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
-			if computability == schema.Required {
-				result.ComputedOptionalRequired = schema.ComputedOptional
-			}
-
-			result.Default = &schema.StringDefault{
-				Static: &staticDefault,
-			}
+	if staticDefault, ok := decodeDefault[string](s, name); ok {
+		if computability == schema.Required {
+			result.ComputedOptionalRequired = schema.ComputedOptional
+		}
+
+		result.Default = &schema.StringDefault{
+			Static: &staticDefault,
 		}
 	}
 
@@ -85,19 +82,7 @@ func (s *OASSchema) BuildStringElementType() (schema.ElementType, *SchemaError)
 func (s *OASSchema) GetStringValidators() []schema.StringValidator {
 	var result []schema.StringValidator
 
-	if len(s.Schema.Enum) > 0 {
-		var enum []string
-
-		for _, valueNode := range s.Schema.Enum {
-			var value string
-			if err := valueNode.Decode(&value); err != nil {
-				// could consider error/panic here to notify developers
-				continue
-			}
-
-			enum = append(enum, value)
-		}
-
+	if enum := decodeEnumValues[string](s); len(enum) > 0 {
 		customValidator := frameworkvalidators.StringValidatorOneOf(enum)
 
 		if customValidator != nil {
@@ -62,6 +62,46 @@ func TestBuildStringResource(t *testing.T) {
 				},
 			},
 		},
+		"string attributes with vendor extensions": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_sensitive_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-terraform-sensitive": {Kind: yaml.ScalarNode, Value: "true"},
+						}),
+					}),
+					"string_not_sensitive_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-terraform-sensitive": {Kind: yaml.ScalarNode, Value: "false"},
+						}),
+					}),
+					"string_ignored_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-terraform-ignore": {Kind: yaml.ScalarNode, Value: "true"},
+						}),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_not_sensitive_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_sensitive_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Sensitive:                pointer(true),
+					},
+				},
+			},
+		},
 		"string attributes default": {
 			schema: &base.Schema{
 				Type:     []string{"object"},
@@ -578,6 +618,52 @@ func TestGetStringValidators(t *testing.T) {
 			},
 			expected: nil,
 		},
+		"const": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:  []string{"string"},
+					Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "fixed"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "one"},
+					},
+				},
+			},
+			expected: []schema.StringValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.OneOf(\n\"fixed\",\n)",
+					},
+				},
+			},
+		},
+		"enum-nullable": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"string", "null"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "one"},
+						{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"},
+					},
+				},
+			},
+			expected: []schema.StringValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.OneOf(\n\"one\",\n)",
+					},
+				},
+			},
+		},
 		"enum": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// unionVariantsExtension is an internal extension added to schemas built by buildUnionSchema, which contains the
+// property names of each union variant. These are used to create the optional variant attributes and their validators.
+const unionVariantsExtension = "x-tfplugingen-union-variants"
+
+// buildUnionSchema will attempt to resolve a oneOf/anyOf schema composition into a single object schema, with one property
+// per variant. This is only possible if at least two of the subschemas are objects (not maps) and all other subschemas
+// are null types. If the composition is not an object union, nil will be returned with no error. The variant schemas are the
+// already built subschemas, in the same order as proxies.
+//
+// Each variant property is named (in priority order) from:
+//   - The key of the discriminator mapping that references the variant
+//   - The last segment of the variant $ref, i.e. "#/components/schemas/GitSource" -> "GitSource"
+//   - The title of the variant schema
+func buildUnionSchema(parent *base.Schema, proxies []*base.SchemaProxy, schemas []*base.Schema, nodeType NodeType) (*base.Schema, *SchemaError) {
+	variantProxies := make([]*base.SchemaProxy, 0)
+	variantSchemas := make([]*base.Schema, 0)
+
+	for i, proxy := range proxies {
+		variantSchema := schemas[i]
+
+		variantType, err := retrieveType(variantSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		if variantType == util.OAS_type_null {
+			continue
+		}
+
+		isMap := variantSchema.AdditionalProperties != nil && variantSchema.AdditionalProperties.IsA()
+		if variantType != util.OAS_type_object || isMap {
+			return nil, nil
+		}
+
+		variantProxies = append(variantProxies, proxy)
+		variantSchemas = append(variantSchemas, variantSchema)
+	}
+
+	if len(variantProxies) < 2 {
+		return nil, nil
+	}
+
+	// Start with a shallow copy of the parent, which preserves the low-level model for line numbers
+	union := *parent
+	union.Type = []string{util.OAS_type_object}
+	union.AllOf = nil
+	union.AnyOf = nil
+	union.OneOf = nil
+	union.Discriminator = nil
+	union.Properties = copyProperties(parent.Properties)
+	if union.Properties == nil {
+		union.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	}
+
+	variantNames := &yaml.Node{Kind: yaml.SequenceNode}
+
+	for i, proxy := range variantProxies {
+		name := getUnionVariantName(parent.Discriminator, proxy, variantSchemas[i])
+		if name == "" {
+			return nil, SchemaErrorFromNode(fmt.Errorf("unable to determine a name for union subschema at index %d, a discriminator mapping, $ref, or title is required", i), parent, nodeType)
+		}
+
+		if _, ok := union.Properties.Get(name); ok {
+			return nil, SchemaErrorFromNode(fmt.Errorf("found multiple union subschemas or properties with the name '%s'", name), parent, nodeType)
+		}
+
+		union.Properties.Set(name, proxy)
+		variantNames.Content = append(variantNames.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name})
+	}
+
+	// Copy the parent extensions, i.e. x-terraform-sensitive, so setting the variant names doesn't modify the parent schema
+	union.Extensions = orderedmap.New[string, *yaml.Node]()
+	if parent.Extensions != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), parent.Extensions) {
+			union.Extensions.Set(pair.Key(), pair.Value())
+		}
+	}
+	union.Extensions.Set(unionVariantsExtension, variantNames)
+
+	return &union, nil
+}
+
+// getUnionVariantName returns the property name of a union variant, returning an empty string if no name could be determined.
+func getUnionVariantName(discriminator *base.Discriminator, proxy *base.SchemaProxy, variantSchema *base.Schema) string {
+	ref := ""
+	if proxy.IsReference() {
+		ref = proxy.GetReference()
+	}
+
+	refName := ref[strings.LastIndex(ref, "/")+1:]
+
+	if discriminator != nil && discriminator.Mapping != nil && ref != "" {
+		// Mapping values can either be a full $ref or just the schema name
+		for pair := range orderedmap.Iterate(context.TODO(), discriminator.Mapping) {
+			if pair.Value() == ref || pair.Value() == refName {
+				return pair.Key()
+			}
+		}
+	}
+
+	if refName != "" {
+		return refName
+	}
+
+	return variantSchema.Title
+}
+
+// unionVariants returns the property names of all union variants, if the schema was built from a oneOf/anyOf object union.
+func unionVariants(schema *base.Schema) []string {
+	if schema == nil || schema.Extensions == nil {
+		return nil
+	}
+
+	variantNames, ok := schema.Extensions.Get(unionVariantsExtension)
+	if !ok || variantNames == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(variantNames.Content))
+	for _, variantName := range variantNames.Content {
+		result = append(result, variantName.Value)
+	}
+
+	return result
+}
+
+// IsUnionVariant checks if a property is a variant of a oneOf/anyOf object union.
+func (s *OASSchema) IsUnionVariant(name string) bool {
+	return slices.Contains(unionVariants(s.Schema), name)
+}
+
+// GetUnionVariantNames returns the Terraform identifiers of all union variants, if the property is a variant of a oneOf/anyOf
+// object union. These are used to build the exactly one of validator on each variant attribute.
+func (s *OASSchema) GetUnionVariantNames(name string) []string {
+	if !s.IsUnionVariant(name) {
+		return nil
+	}
+
+	variants := unionVariants(s.Schema)
+
+	result := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		result = append(result, util.TerraformIdentifier(s.GetAliasedName(variant)))
+	}
+
+	return result
+}
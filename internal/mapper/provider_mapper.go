@@ -22,8 +22,7 @@ type ProviderMapper interface {
 
 type providerMapper struct {
 	provider explorer.Provider
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg      config.Config
 }
 
 func NewProviderMapper(exploredProvider explorer.Provider, cfg config.Config) ProviderMapper {
@@ -44,7 +43,7 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 
 	pLogger := logger.With("provider", providerIR.Name)
 
-	providerSchema, err := generateProviderSchema(pLogger, m.provider)
+	providerSchema, err := generateProviderSchema(pLogger, m.provider, m.cfg.Options)
 	if err != nil {
 		return nil, err
 	}
@@ -53,17 +52,25 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 	return &providerIR, nil
 }
 
-func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider) (*provider.Schema, error) {
+func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider, opts config.Options) (*provider.Schema, error) {
 	providerSchema := &provider.Schema{}
 
 	schemaOpts := oas.SchemaOpts{
 		Ignores: exploredProvider.Ignores,
 	}
-	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, oas.GlobalSchemaOpts{})
+	globalSchemaOpts := oas.GlobalSchemaOpts{
+		DefaultIntegerFormat: opts.DefaultIntegerFormat,
+	}
+	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	unusedErr := checkUnusedIgnores(logger, opts.Strict, exploredProvider.Ignores, []*oas.OASSchema{s}, nil)
+	if unusedErr != nil {
+		return nil, unusedErr
+	}
+
 	attributes, err := s.BuildProviderAttributes()
 	if err != nil {
 		log.WarnLogOnError(logger, err, "error mapping provider schema")
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// DefaultImportSeparator is the string between each attribute in an import ID, if not set in the generator config.
+const DefaultImportSeparator = "/"
+
+// ResourceImport describes the import ID of a resource, i.e. `org_id/id`. This isn't part of the provider code spec, but is
+// recorded alongside the resource schema for downstream code generators.
+type ResourceImport struct {
+	// IDFormat is the Terraform identifier of each attribute in the import ID, joined by the separator.
+	IDFormat string `json:"id_format"`
+
+	// Separator is the string between each attribute in the import ID.
+	Separator string `json:"separator"`
+
+	// Attributes are the Terraform identifiers of each top-level attribute in the import ID, in order.
+	Attributes []string `json:"attributes"`
+}
+
+// buildResourceImport derives the import ID of a resource from the path parameters of the read operation, with the separator and
+// attributes optionally set in the generator config. Returns nil if the import ID has no attributes, i.e. a singleton resource.
+//
+// If an import ID attribute doesn't exist in the resource schema, i.e. the path parameter is ignored, a warning is logged and no
+// import ID is returned. In strict mode, attributes from the generator config that don't exist are returned as an error.
+func buildResourceImport(logger *slog.Logger, explorerResource explorer.Resource, identity *ResourceIdentity, resourceSchema *resource.Schema, opts config.Options) (*ResourceImport, error) {
+	resourceImport := &ResourceImport{
+		Separator: DefaultImportSeparator,
+	}
+
+	if explorerResource.Import != nil && explorerResource.Import.Separator != "" {
+		resourceImport.Separator = explorerResource.Import.Separator
+	}
+
+	configured := explorerResource.Import != nil && len(explorerResource.Import.Attributes) > 0
+	if configured {
+		for _, attribute := range explorerResource.Import.Attributes {
+			resourceImport.Attributes = append(resourceImport.Attributes, util.TerraformIdentifier(attribute))
+		}
+	}
+
+	if !configured {
+		for _, paramName := range util.PathParameters(explorerResource.ReadPath) {
+			param := &high.Parameter{Name: paramName, In: util.OAS_param_path}
+			attribute := readParameterAttributeName(explorerResource, identity, param)
+
+			resourceImport.Attributes = append(resourceImport.Attributes, util.TerraformIdentifier(attribute))
+		}
+	}
+
+	if len(resourceImport.Attributes) == 0 {
+		return nil, nil
+	}
+
+	for _, attribute := range resourceImport.Attributes {
+		exists := slices.ContainsFunc(resourceSchema.Attributes, func(a resource.Attribute) bool {
+			return a.Name == attribute
+		})
+		if exists {
+			continue
+		}
+
+		logger.Warn("skipping import ID, attribute not found in resource schema", "attribute", attribute)
+
+		if configured && opts.Strict {
+			return nil, fmt.Errorf("%w: import attribute '%s' did not match any attribute", ErrUnusedSchemaOption, attribute)
+		}
+
+		return nil, nil
+	}
+
+	resourceImport.IDFormat = strings.Join(resourceImport.Attributes, resourceImport.Separator)
+
+	return resourceImport, nil
+}
@@ -5,6 +5,7 @@ package mapper
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
@@ -20,13 +21,24 @@ import (
 var _ ResourceMapper = resourceMapper{}
 
 type ResourceMapper interface {
-	MapToIR(*slog.Logger) ([]resource.Resource, error)
+	MapToIR(*slog.Logger) ([]Resource, error)
+}
+
+// Resource is a mapped provider code spec resource, along with information about the resource that isn't part of the provider
+// code spec schema. The additional fields are recorded alongside the resource in the generated provider code spec.
+type Resource struct {
+	resource.Resource
+
+	// Identity is the attribute that identifies the resource, if declared in the generator config.
+	Identity *ResourceIdentity `json:"identity,omitempty"`
+
+	// Import is the format of the import ID, if the read operation has path parameters.
+	Import *ResourceImport `json:"import,omitempty"`
 }
 
 type resourceMapper struct {
 	resources map[string]explorer.Resource
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg       config.Config
 }
 
 func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config) ResourceMapper {
@@ -36,8 +48,8 @@ func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config
 	}
 }
 
-func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error) {
-	resourceSchemas := []resource.Resource{}
+func (m resourceMapper) MapToIR(logger *slog.Logger) ([]Resource, error) {
+	resourceSchemas := []Resource{}
 
 	// Guarantee the order of processing
 	resourceNames := util.SortedKeys(m.resources)
@@ -45,22 +57,52 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error
 		explorerResource := m.resources[name]
 		rLogger := logger.With("resource", name)
 
-		schema, err := generateResourceSchema(rLogger, explorerResource)
+		identity, err := resolveIdentity(explorerResource)
+		if err != nil {
+			log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
+			continue
+		}
+
+		schema, identity, err := generateResourceSchema(rLogger, explorerResource, identity, m.cfg.Options)
 		if err != nil {
+			if isFatalMappingError(err) {
+				return nil, fmt.Errorf("resource '%s': %w", name, err)
+			}
+
 			log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
 			continue
 		}
 
-		resourceSchemas = append(resourceSchemas, resource.Resource{
-			Name:   name,
-			Schema: schema,
-		})
+		resourceImport, err := buildResourceImport(rLogger, explorerResource, identity, schema, m.cfg.Options)
+		if err != nil {
+			return nil, fmt.Errorf("resource '%s': %w", name, err)
+		}
+
+		mappedResource := Resource{
+			Resource: resource.Resource{
+				Name:   name,
+				Schema: schema,
+			},
+			Import: resourceImport,
+		}
+
+		if identity != nil {
+			mappedResource.Identity = &ResourceIdentity{
+				Attribute: util.TerraformIdentifier(identity.Attribute),
+				Parameter: identity.Parameter,
+				Path:      identity.Path,
+			}
+		}
+
+		resourceSchemas = append(resourceSchemas, mappedResource)
 	}
 
 	return resourceSchemas, nil
 }
 
-func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource) (*resource.Schema, error) {
+// generateResourceSchema maps the resource schema, and returns it with the identity that was applied to it. A detected identity
+// that can't be applied is dropped.
+func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource, identity *ResourceIdentity, opts config.Options) (*resource.Schema, *ResourceIdentity, error) {
 	resourceSchema := &resource.Schema{
 		Attributes: []resource.Attribute{},
 	}
@@ -72,14 +114,69 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 
 	schemaOpts := oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases: explorerResource.SchemaOptions.AttributeOptions.Aliases,
 	}
-	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, oas.GlobalSchemaOpts{})
+	globalSchemaOpts := oas.GlobalSchemaOpts{
+		DefaultIntegerFormat: opts.DefaultIntegerFormat,
+		Logger:               logger,
+	}
+	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	createRequestAttributes, schemaErr := createRequestSchema.BuildResourceAttributes()
 	if schemaErr != nil {
-		return nil, schemaErr
+		return nil, nil, schemaErr
+	}
+	mappedSchemas := []*oas.OASSchema{createRequestSchema}
+
+	// The create request attributes are copied for the requires replace plan modifiers, as merging modifies them
+	planCreateAttributes := createRequestAttributes.Clone()
+
+	// *********************
+	// Update Request Body (optional)
+	// *********************
+	// The update request body is always mapped for the requires replace plan modifiers, but it's only merged into the
+	// resource schema if not skipped
+	updateRequestAttributes := attrmapper.ResourceAttributes{}
+	var planUpdateAttributes attrmapper.ResourceAttributes
+	if explorerResource.UpdateOp != nil {
+		logger.Debug("searching for update operation request body")
+
+		schemaOpts = oas.SchemaOpts{
+			Ignores: explorerResource.SchemaOptions.Ignores,
+			Aliases: explorerResource.SchemaOptions.AttributeOptions.Aliases,
+		}
+		globalSchemaOpts = oas.GlobalSchemaOpts{
+			OverrideComputability: schema.ComputedOptional,
+			DefaultIntegerFormat:  opts.DefaultIntegerFormat,
+			Logger:                logger,
+		}
+		updateRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.UpdateOp, schemaOpts, globalSchemaOpts)
+		if err != nil {
+			if errors.Is(err, oas.ErrSchemaNotFound) {
+				// Demote log to INFO if there was no schema found
+				logger.Info("skipping mapping of update operation request body", "err", err)
+			} else {
+				logger.Warn("skipping mapping of update operation request body", "err", err)
+			}
+		} else {
+			if !explorerResource.SkipUpdateRequest {
+				mappedSchemas = append(mappedSchemas, updateRequestSchema)
+			}
+
+			updateRequestAttributes, schemaErr = updateRequestSchema.BuildResourceAttributes()
+			if schemaErr != nil {
+				log.WarnLogOnError(logger, schemaErr, "skipping mapping of update operation request body")
+			} else {
+				// The update request attributes are copied for the requires replace plan modifiers, as merging modifies them
+				planUpdateAttributes = updateRequestAttributes.Clone()
+			}
+
+			if explorerResource.SkipUpdateRequest {
+				updateRequestAttributes = attrmapper.ResourceAttributes{}
+			}
+		}
 	}
 
 	// *********************
@@ -90,9 +187,12 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	createResponseAttributes := attrmapper.ResourceAttributes{}
 	schemaOpts = oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases: explorerResource.SchemaOptions.AttributeOptions.Aliases,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
+	globalSchemaOpts = oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		DefaultIntegerFormat:  opts.DefaultIntegerFormat,
+		Logger:                logger,
 	}
 	createResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
@@ -103,6 +203,7 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			logger.Warn("skipping mapping of create operation response body", "err", err)
 		}
 	} else {
+		mappedSchemas = append(mappedSchemas, createResponseSchema)
 		createResponseAttributes, schemaErr = createResponseSchema.BuildResourceAttributes()
 		if schemaErr != nil {
 			log.WarnLogOnError(logger, schemaErr, "skipping mapping of create operation response body")
@@ -118,9 +219,12 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 
 	schemaOpts = oas.SchemaOpts{
 		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases: explorerResource.SchemaOptions.AttributeOptions.Aliases,
 	}
 	globalSchemaOpts = oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		DefaultIntegerFormat:  opts.DefaultIntegerFormat,
+		Logger:                logger,
 	}
 	readResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
@@ -131,6 +235,7 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			logger.Warn("skipping mapping of read operation response body", "err", err)
 		}
 	} else {
+		mappedSchemas = append(mappedSchemas, readResponseSchema)
 		readResponseAttributes, schemaErr = readResponseSchema.BuildResourceAttributes()
 		if schemaErr != nil {
 			log.WarnLogOnError(logger, schemaErr, "skipping mapping of read operation response body")
@@ -141,6 +246,8 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	// READ Parameters (optional)
 	// ****************
 	readParameterAttributes := attrmapper.ResourceAttributes{}
+	readParameterNames := []string{}
+	readParameterOASNames := []string{}
 	for _, param := range explorerResource.ReadOpParameters() {
 		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
 			continue
@@ -151,7 +258,11 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			Ignores:             explorerResource.SchemaOptions.Ignores,
 			OverrideDescription: param.Description,
 		}
-		globalSchemaOpts := oas.GlobalSchemaOpts{OverrideComputability: schema.ComputedOptional}
+		globalSchemaOpts := oas.GlobalSchemaOpts{
+			OverrideComputability: schema.ComputedOptional,
+			DefaultIntegerFormat:  opts.DefaultIntegerFormat,
+			Logger:                pLogger,
+		}
 
 		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
 		if schemaErr != nil {
@@ -159,12 +270,13 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			continue
 		}
 
-		// Check for any aliases and replace the paramater name if found
-		paramName := param.Name
-		if aliasedName, ok := explorerResource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
-			pLogger = pLogger.With("param_alias", aliasedName)
-			paramName = aliasedName
+		// Check for the identity parameter or any aliases and replace the paramater name if found
+		readParameterOASNames = append(readParameterOASNames, param.Name)
+		paramName := readParameterAttributeName(explorerResource, identity, param)
+		if paramName != param.Name {
+			pLogger = pLogger.With("param_alias", paramName)
 		}
+		readParameterNames = append(readParameterNames, paramName)
 
 		if s.IsPropertyIgnored(paramName) {
 			continue
@@ -179,12 +291,71 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 		readParameterAttributes = append(readParameterAttributes, parameterAttribute)
 	}
 
-	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
-	resourceAttributes, _ := createRequestAttributes.Merge(createResponseAttributes, readResponseAttributes, readParameterAttributes)
+	var mergeErr error
+	resourceAttributes := createRequestAttributes
+	for _, mergeSlice := range []struct {
+		attributes attrmapper.ResourceAttributes
+		source     mergeSource
+	}{
+		{updateRequestAttributes, updateRequestSource},
+		{createResponseAttributes, createResponseSource},
+		{readResponseAttributes, readResponseSource},
+		{readParameterAttributes, readParameterSource},
+	} {
+		var err error
+		resourceAttributes, err = mergeResourceAttributes(logger, opts.MergeStrategy, resourceAttributes, mergeSlice.attributes, mergeSlice.source)
+		mergeErr = errors.Join(mergeErr, err)
+	}
+	if mergeErr != nil {
+		return nil, nil, mergeErr
+	}
 
-	// TODO: handle error for overrides
-	resourceAttributes, _ = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	switch {
+	case identity != nil && explorerResource.Identity == nil:
+		resourceAttributes, identity = applyDetectedIdentity(logger, resourceAttributes, identity)
+	case identity != nil:
+		resourceAttributes, err = applyIdentity(resourceAttributes, identity)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	resourceAttributes, err = applyOverrides(resourceAttributes, explorerResource.SchemaOptions.AttributeOptions)
+	err = errors.Join(
+		checkUnusedIgnores(logger, opts.Strict, explicitIgnores(explorerResource.SchemaOptions), mappedSchemas, readParameterNames),
+		checkUnusedAliases(logger, opts.Strict, explicitAliases(explorerResource.SchemaOptions.AttributeOptions), mappedSchemas, readParameterOASNames),
+		checkOverrideErrors(logger, opts.Strict, err),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ****************
+	// Plan Modifiers
+	// ****************
+	applyPlanModifiers(logger, explorerResource, resourceAttributes, planCreateAttributes, planUpdateAttributes, opts)
 
 	resourceSchema.Attributes = resourceAttributes.ToSpec()
-	return resourceSchema, nil
+	return resourceSchema, identity, nil
+}
+
+// applyPlanModifiers adds RequiresReplace plan modifiers to all create request body attributes that are not in the update
+// request body, and optionally adds UseStateForUnknown plan modifiers to all computed-only attributes. The create and update request
+// attributes must not have been merged with any other attributes. If the update request body wasn't mapped, no RequiresReplace
+// plan modifiers are added.
+func applyPlanModifiers(logger *slog.Logger, explorerResource explorer.Resource, resourceAttributes attrmapper.ResourceAttributes, createRequestAttributes attrmapper.ResourceAttributes, updateRequestAttributes attrmapper.ResourceAttributes, opts config.Options) {
+	if opts.UseStateForUnknown {
+		resourceAttributes.ApplyUseStateForUnknown()
+	}
+
+	if explorerResource.UpdateOp == nil {
+		return
+	}
+
+	if updateRequestAttributes == nil {
+		logger.Info("skipping requires replace plan modifiers, update operation request body was not mapped")
+		return
+	}
+
+	resourceAttributes.ApplyRequiresReplace(createRequestAttributes, updateRequestAttributes)
 }
@@ -4,7 +4,10 @@
 package mapper_test
 
 import (
+	"bytes"
 	"log/slog"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
@@ -13,12 +16,14 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestResourceMapper_basic_merges(t *testing.T) {
@@ -1065,6 +1070,1006 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 	}
 }
 
+func TestResourceMapper_merge_strategy(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"port"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"port": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "port as a string",
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"port": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"integer"},
+				Description: "port as an integer",
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		mergeStrategy    string
+		want             resource.Attributes
+		expectedErrRegex string
+	}{
+		"default - keeps first attribute": {
+			want: resource.Attributes{
+				{
+					Name: "port",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("port as a string"),
+					},
+				},
+			},
+		},
+		"warn - keeps first attribute": {
+			mergeStrategy: config.MergeStrategyWarn,
+			want: resource.Attributes{
+				{
+					Name: "port",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("port as a string"),
+					},
+				},
+			},
+		},
+		"prefer-response - replaces attribute and keeps computability": {
+			mergeStrategy: config.MergeStrategyPreferResponse,
+			want: resource.Attributes{
+				{
+					Name: "port",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("port as an integer"),
+					},
+				},
+			},
+		},
+		"fail - returns error": {
+			mergeStrategy:    config.MergeStrategyFail,
+			expectedErrRegex: `resource 'test_resource': attribute 'port' has conflicting types: string and int64`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(readResponseSchema, nil),
+				},
+			}, config.Config{
+				Options: config.Options{
+					MergeStrategy: testCase.mergeStrategy,
+				},
+			})
+			got, err := mapper.MapToIR(slog.Default())
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error to match %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_update_request(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	updateRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name", "enabled"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"enabled": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"boolean"},
+				Description: "only updatable after creation",
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		skipUpdateRequest bool
+		want              resource.Attributes
+	}{
+		"update request body merged as computed_optional": {
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "enabled",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("only updatable after creation"),
+					},
+				},
+			},
+		},
+		"update request body skipped": {
+			skipUpdateRequest: true,
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:          createTestCreateOp(createRequestSchema, nil),
+					UpdateOp:          createTestUpdateOp(updateRequestSchema),
+					SkipUpdateRequest: testCase.skipUpdateRequest,
+				},
+			}, config.Config{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_plan_modifiers(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"tags": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				Default: &yaml.Node{Kind: yaml.ScalarNode, Value: "not-a-list"},
+			}),
+			"zone": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	updateRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"zone": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	want := resource.Attributes{
+		{
+			Name: "name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+		{
+			Name: "tags",
+			List: &resource.ListAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+				PlanModifiers: []schema.ListPlanModifier{
+					{
+						Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.ListPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "zone",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				PlanModifiers: []schema.StringPlanModifier{
+					{
+						Custom: frameworkplanmodifiers.RequiresReplace(frameworkplanmodifiers.StringPlanModifierPackage),
+					},
+				},
+			},
+		},
+		{
+			Name: "id",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		skipUpdateRequest bool
+	}{
+		"update request body merged": {},
+		"update request body skipped": {
+			skipUpdateRequest: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:          createTestCreateOp(createRequestSchema, nil),
+					ReadOp:            createTestReadOp(readResponseSchema, nil),
+					UpdateOp:          createTestUpdateOp(updateRequestSchema),
+					SkipUpdateRequest: testCase.skipUpdateRequest,
+				},
+			}, config.Config{})
+			got, err := mapper.MapToIR(slog.New(slog.NewTextHandler(&logs, nil)))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// The create request body is only mapped once, so mapping warnings are only logged once
+			if count := strings.Count(logs.String(), "skipping default"); count != 1 {
+				t.Errorf("expected one default warning, got %d: %s", count, logs.String())
+			}
+		})
+	}
+}
+
+func TestResourceMapper_identity(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "the widget id",
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readParams := []*high.Parameter{
+		{
+			Name:     "orgId",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name:     "widgetId",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		identity      *explorer.Identity
+		schemaOptions explorer.SchemaOptions
+		wantIdentity  *mapper.ResourceIdentity
+		want          resource.Attributes
+	}{
+		"identity detected without config": {
+			wantIdentity: &mapper.ResourceIdentity{
+				Attribute: "id",
+				Parameter: "widgetId",
+				Path:      "/orgs/{orgId}/widgets/{widgetId}",
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("the widget id"),
+					},
+				},
+				{
+					Name: "org_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"identity not detected for aliased parameter": {
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"widgetId": "widget",
+					},
+				},
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("the widget id"),
+					},
+				},
+				{
+					Name: "org_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "widget",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"identity parameter detected from read path": {
+			identity: &explorer.Identity{
+				Attribute: "id",
+			},
+			wantIdentity: &mapper.ResourceIdentity{
+				Attribute: "id",
+				Parameter: "widgetId",
+				Path:      "/orgs/{orgId}/widgets/{widgetId}",
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("the widget id"),
+					},
+				},
+				{
+					Name: "org_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"identity parameter from config": {
+			identity: &explorer.Identity{
+				Attribute: "organization",
+				Parameter: "orgId",
+			},
+			wantIdentity: &mapper.ResourceIdentity{
+				Attribute: "organization",
+				Parameter: "orgId",
+				Path:      "/orgs/{orgId}/widgets/{widgetId}",
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("the widget id"),
+					},
+				},
+				{
+					Name: "organization",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "widget_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:      createTestCreateOp(createRequestSchema, nil),
+					ReadOp:        createTestReadOp(readResponseSchema, readParams),
+					ReadPath:      "/orgs/{orgId}/widgets/{widgetId}",
+					Identity:      testCase.identity,
+					SchemaOptions: testCase.schemaOptions,
+				},
+			}, config.Config{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Identity, testCase.wantIdentity); diff != "" {
+				t.Errorf("unexpected identity difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_identity_not_found(t *testing.T) {
+	t.Parallel()
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}), nil),
+			ReadOp:   createTestReadOp(nil, nil),
+			ReadPath: "/widgets/{widgetId}",
+			Identity: &explorer.Identity{
+				Attribute: "id",
+			},
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The resource is skipped, as the read operation doesn't define the 'widgetId' path parameter
+	if len(got) != 0 {
+		t.Fatalf("expected no resources, got: %d", len(got))
+	}
+}
+
+func TestResourceMapper_detected_identity_not_applied(t *testing.T) {
+	t.Parallel()
+
+	// The detected 'widgetId' identity parameter and the 'id' property can't be mapped, as the array items have no type
+	arraySchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"array"},
+		Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+			A: base.CreateSchemaProxy(&base.Schema{}),
+		},
+	})
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}), nil),
+			ReadOp: createTestReadOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"id": arraySchema,
+				}),
+			}), []*high.Parameter{
+				{
+					Name:     "widgetId",
+					Required: pointer(true),
+					In:       "path",
+					Schema:   arraySchema,
+				},
+			}),
+			ReadPath: "/widgets/{widgetId}",
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The resource is mapped without the detected identity
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	if got[0].Identity != nil {
+		t.Errorf("expected no identity, got: %+v", got[0].Identity)
+	}
+
+	want := resource.Attributes{
+		{
+			Name: "name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+			},
+		},
+	}
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_import(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+	readParams := []*high.Parameter{
+		{
+			Name:     "orgId",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name:     "widgetId",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		readPath         string
+		identity         *explorer.Identity
+		resourceImport   *explorer.Import
+		schemaOptions    explorer.SchemaOptions
+		strict           bool
+		want             *mapper.ResourceImport
+		expectedErrRegex string
+	}{
+		"derived from read path with detected identity": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			want: &mapper.ResourceImport{
+				IDFormat:   "org_id/id",
+				Separator:  "/",
+				Attributes: []string{"org_id", "id"},
+			},
+		},
+		"derived from read path with identity and alias": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			identity: &explorer.Identity{
+				Attribute: "id",
+			},
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"orgId": "organization",
+					},
+				},
+			},
+			want: &mapper.ResourceImport{
+				IDFormat:   "organization/id",
+				Separator:  "/",
+				Attributes: []string{"organization", "id"},
+			},
+		},
+		"no path parameters": {
+			readPath: "/widget",
+		},
+		"separator from config": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			resourceImport: &explorer.Import{
+				Separator: ":",
+			},
+			want: &mapper.ResourceImport{
+				IDFormat:   "org_id:id",
+				Separator:  ":",
+				Attributes: []string{"org_id", "id"},
+			},
+		},
+		"attributes from config": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			resourceImport: &explorer.Import{
+				Attributes: []string{"id", "org_id"},
+			},
+			want: &mapper.ResourceImport{
+				IDFormat:   "id/org_id",
+				Separator:  "/",
+				Attributes: []string{"id", "org_id"},
+			},
+		},
+		"attribute not found": {
+			// The 'partId' path parameter isn't defined by the read operation
+			readPath: "/orgs/{orgId}/widgets/{widgetId}/parts/{partId}",
+		},
+		"attribute from config not found": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			resourceImport: &explorer.Import{
+				Attributes: []string{"org_id", "widget"},
+			},
+		},
+		"attribute from config not found - strict": {
+			readPath: "/orgs/{orgId}/widgets/{widgetId}",
+			resourceImport: &explorer.Import{
+				Attributes: []string{"org_id", "widget"},
+			},
+			strict:           true,
+			expectedErrRegex: `resource 'test_resource': unused schema option: import attribute 'widget' did not match any attribute`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:      createTestCreateOp(createRequestSchema, nil),
+					ReadOp:        createTestReadOp(readResponseSchema, readParams),
+					ReadPath:      testCase.readPath,
+					Identity:      testCase.identity,
+					Import:        testCase.resourceImport,
+					SchemaOptions: testCase.schemaOptions,
+				},
+			}, config.Config{
+				Options: config.Options{
+					Strict: testCase.strict,
+				},
+			})
+			got, err := mapper.MapToIR(slog.Default())
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error to match %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Import, testCase.want); diff != "" {
+				t.Errorf("unexpected import difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_unused_schema_options(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"tags": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"key": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+			}),
+		}),
+	})
+	readParams := []*high.Parameter{
+		{
+			Name:     "id",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		strict           bool
+		schemaOptions    explorer.SchemaOptions
+		expectedErrRegex string
+	}{
+		"all schema options used": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"id", "tags.key"},
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name": {Description: "new description"},
+					},
+				},
+			},
+		},
+		"all wildcard schema options used": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"**.key", "t*"},
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"n?me": {Description: "new description"},
+					},
+				},
+			},
+		},
+		"unused wildcard ignore - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"**.value"},
+			},
+			expectedErrRegex: `resource 'test_resource': unused schema option: ignore '\*\*\.value' did not match any attribute`,
+		},
+		"unused inherited schema options - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				Ignores:          []string{"_links", "tags.key"},
+				InheritedIgnores: []string{"_links"},
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"**.etag": {Description: "new description"},
+						"name":    {Description: "new description"},
+					},
+					InheritedOverrides: []string{"**.etag"},
+				},
+			},
+		},
+		"unused schema options - warn": {
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"tags.value"},
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"renamed": {Description: "new description"},
+					},
+				},
+			},
+		},
+		"unused alias - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"id":        "identifier",
+						"tags.key":  "label",
+						"tags.kind": "type",
+					},
+				},
+			},
+			expectedErrRegex: `resource 'test_resource': unused schema option: alias 'tags.kind' did not match any parameter or attribute`,
+		},
+		"unused inherited alias - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"widgetId": "id",
+					},
+					InheritedAliases: []string{"widgetId"},
+				},
+			},
+		},
+		"unused ignore - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"tags.value"},
+			},
+			expectedErrRegex: `resource 'test_resource': unused schema option: ignore 'tags.value' did not match any attribute`,
+		},
+		"unused override - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name.nested": {Description: "new description"},
+					},
+				},
+			},
+			expectedErrRegex: `resource 'test_resource': unused schema option: override 'name.nested' - no matching attribute found, 'name' is not a nested attribute`,
+		},
+		"invalid override type - strict": {
+			strict: true,
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name": {Type: "set"},
+					},
+				},
+			},
+			expectedErrRegex: `resource 'test_resource': invalid override: .*unable to override attribute 'name' of type string to type set`,
+		},
+		"invalid override type - warn": {
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name": {Type: "set"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:      createTestCreateOp(createRequestSchema, nil),
+					ReadOp:        createTestReadOp(nil, readParams),
+					SchemaOptions: testCase.schemaOptions,
+				},
+			}, config.Config{
+				Options: config.Options{
+					Strict: testCase.strict,
+				},
+			})
+			got, err := mapper.MapToIR(slog.Default())
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error to match %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+		})
+	}
+}
+
 func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *high.Operation {
 	return &high.Operation{
 		RequestBody: &high.RequestBody{
@@ -1088,6 +2093,18 @@ func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *
 	}
 }
 
+func createTestUpdateOp(request *base.SchemaProxy) *high.Operation {
+	return &high.Operation{
+		RequestBody: &high.RequestBody{
+			Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+				"application/json": {
+					Schema: request,
+				},
+			}),
+		},
+	}
+}
+
 func createTestReadOp(response *base.SchemaProxy, params []*high.Parameter) *high.Operation {
 	return &high.Operation{
 		Responses: &high.Responses{
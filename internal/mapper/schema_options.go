@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// ErrUnusedSchemaOption is returned in strict mode when an ignore, alias, or override in the generator config doesn't match any attribute.
+var ErrUnusedSchemaOption = errors.New("unused schema option")
+
+// ErrInvalidOverride is returned in strict mode when an override in the generator config matches an attribute, but can't be applied,
+// i.e. an unsupported `type` conversion.
+var ErrInvalidOverride = errors.New("invalid override")
+
+// checkUnusedIgnores logs a warning for each ignore that doesn't match a property in any of the mapped schemas or parameter names. In
+// strict mode, all unused ignores are returned as an error.
+func checkUnusedIgnores(logger *slog.Logger, strict bool, ignores []string, schemas []*oas.OASSchema, paramNames []string) error {
+	var errResult error
+
+	for _, ignore := range ignores {
+		if slices.Contains(paramNames, ignore) {
+			continue
+		}
+
+		used := slices.ContainsFunc(schemas, func(s *oas.OASSchema) bool {
+			return s.HasPropertyPath(ignore)
+		})
+		if used {
+			continue
+		}
+
+		logger.Warn("ignore did not match any attribute", "path", ignore)
+
+		if strict {
+			errResult = errors.Join(errResult, fmt.Errorf("%w: ignore '%s' did not match any attribute", ErrUnusedSchemaOption, ignore))
+		}
+	}
+
+	return errResult
+}
+
+// explicitIgnores returns the ignores of a resource or data source, without the ignores inherited from the generator config defaults. Only
+// explicit ignores are checked for unused ignores, as inherited ignores aren't expected to apply to every resource and data source.
+func explicitIgnores(schemaOpts explorer.SchemaOptions) []string {
+	if len(schemaOpts.InheritedIgnores) == 0 {
+		return schemaOpts.Ignores
+	}
+
+	ignores := make([]string, 0, len(schemaOpts.Ignores))
+	for _, ignore := range schemaOpts.Ignores {
+		if !slices.Contains(schemaOpts.InheritedIgnores, ignore) {
+			ignores = append(ignores, ignore)
+		}
+	}
+
+	return ignores
+}
+
+// checkUnusedAliases logs a warning for each alias that doesn't match a parameter name or a property in any of the mapped schemas. In
+// strict mode, all unused aliases are returned as an error.
+func checkUnusedAliases(logger *slog.Logger, strict bool, aliases []string, schemas []*oas.OASSchema, paramNames []string) error {
+	var errResult error
+
+	for _, alias := range aliases {
+		if slices.Contains(paramNames, alias) {
+			continue
+		}
+
+		used := slices.ContainsFunc(schemas, func(s *oas.OASSchema) bool {
+			return s.HasPropertyPath(alias)
+		})
+		if used {
+			continue
+		}
+
+		logger.Warn("alias did not match any parameter or attribute", "path", alias)
+
+		if strict {
+			errResult = errors.Join(errResult, fmt.Errorf("%w: alias '%s' did not match any parameter or attribute", ErrUnusedSchemaOption, alias))
+		}
+	}
+
+	return errResult
+}
+
+// explicitAliases returns the sorted alias locations of a resource or data source, without the aliases inherited from the generator
+// config defaults.
+func explicitAliases(attributeOpts explorer.AttributeOptions) []string {
+	aliases := make([]string, 0, len(attributeOpts.Aliases))
+	for _, location := range util.SortedKeys(attributeOpts.Aliases) {
+		if !slices.Contains(attributeOpts.InheritedAliases, location) {
+			aliases = append(aliases, location)
+		}
+	}
+
+	return aliases
+}
+
+// overridableAttributes are resource or data source attributes that overrides can be applied to.
+type overridableAttributes[T any] interface {
+	ApplyOverrides(map[string]explorer.Override) (T, error)
+}
+
+// applyOverrides applies the overrides inherited from the generator config defaults, followed by all other overrides. Inherited overrides
+// that don't match an attribute are skipped, as they aren't expected to apply to every resource and data source.
+func applyOverrides[T overridableAttributes[T]](attributes T, attributeOpts explorer.AttributeOptions) (T, error) {
+	inheritedOverrides := map[string]explorer.Override{}
+	overrides := map[string]explorer.Override{}
+	for path, override := range attributeOpts.Overrides {
+		if slices.Contains(attributeOpts.InheritedOverrides, path) {
+			inheritedOverrides[path] = override
+		} else {
+			overrides[path] = override
+		}
+	}
+
+	var errResult error
+	attributes, inheritedErr := attributes.ApplyOverrides(inheritedOverrides)
+	for _, err := range util.FlattenErrors(inheritedErr) {
+		if !errors.Is(err, attrmapper.ErrUnmatchedOverride) {
+			errResult = errors.Join(errResult, err)
+		}
+	}
+
+	attributes, err := attributes.ApplyOverrides(overrides)
+
+	return attributes, errors.Join(errResult, err)
+}
+
+// checkOverrideErrors logs a warning for each override that couldn't be applied, i.e. the override path doesn't match any attribute. In
+// strict mode, all override errors are returned as an error, wrapping ErrUnusedSchemaOption for an override that doesn't match any
+// attribute, or ErrInvalidOverride for any other override error.
+func checkOverrideErrors(logger *slog.Logger, strict bool, overrideErr error) error {
+	if overrideErr == nil {
+		return nil
+	}
+
+	var errResult error
+	for _, err := range util.FlattenErrors(overrideErr) {
+		sentinelErr := ErrInvalidOverride
+		if errors.Is(err, attrmapper.ErrUnmatchedOverride) {
+			sentinelErr = ErrUnusedSchemaOption
+			logger.Warn("override did not match any attribute", "err", err)
+		} else {
+			logger.Warn("error applying attribute override", "err", err)
+		}
+
+		if strict {
+			errResult = errors.Join(errResult, fmt.Errorf("%w: %w", sentinelErr, err))
+		}
+	}
+
+	return errResult
+}
+
+// isFatalMappingError checks if an error from mapping a resource or data source schema should fail generation, rather than skipping
+// the resource or data source.
+func isFatalMappingError(err error) bool {
+	var conflictErr *attrmapper.MergeConflictError
+
+	return errors.As(err, &conflictErr) || errors.Is(err, ErrUnusedSchemaOption) || errors.Is(err, ErrInvalidOverride)
+}
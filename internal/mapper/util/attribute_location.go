@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"path"
+	"strings"
+)
+
+// AnyAttributes is an attribute location segment that matches zero or more nested attributes, i.e. `**.links` matches `links`,
+// `metadata.links`, and `spec.template.links`.
+const AnyAttributes = "**"
+
+// IsAttributeLocationPattern checks if a dot-separated attribute location contains wildcards, either `*` (any single attribute
+// name), `**` (zero or more nested attributes), `?`, or a `[...]` character class.
+func IsAttributeLocationPattern(location []string) bool {
+	for _, segment := range location {
+		if strings.ContainsAny(segment, "*?[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchAttributeName matches the first segment of an attribute location against an attribute name, returning the remaining
+// attribute location for each way the name can be matched. An empty remaining location means the attribute itself is matched.
+// Returns nil if the attribute name isn't matched.
+//
+//   - MatchAttributeName([metadata etag], "metadata") = [[etag]]
+//   - MatchAttributeName([* etag], "metadata") = [[etag]]
+//   - MatchAttributeName([** links], "links") = [[** links] []]
+func MatchAttributeName(location []string, name string) [][]string {
+	if len(location) == 0 {
+		return nil
+	}
+
+	if location[0] == AnyAttributes {
+		// `**` either matches this attribute and continues to nested attributes, or matches zero attributes
+		return append([][]string{location}, MatchAttributeName(location[1:], name)...)
+	}
+
+	if matched, err := path.Match(location[0], name); err != nil || !matched {
+		return nil
+	}
+
+	return [][]string{location[1:]}
+}
+
+// MatchesAttributeName checks if an attribute location matches the attribute name itself, rather than a nested attribute.
+func MatchesAttributeName(location []string, name string) bool {
+	for _, remaining := range MatchAttributeName(location, name) {
+		if len(remaining) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
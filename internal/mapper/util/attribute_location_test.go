@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+func TestMatchAttributeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		location string
+		name     string
+		want     [][]string
+	}{
+		"literal match": {
+			location: "metadata.etag",
+			name:     "metadata",
+			want:     [][]string{{"etag"}},
+		},
+		"literal attribute match": {
+			location: "metadata",
+			name:     "metadata",
+			want:     [][]string{{}},
+		},
+		"literal no match": {
+			location: "metadata.etag",
+			name:     "spec",
+		},
+		"single wildcard": {
+			location: "*.metadata.etag",
+			name:     "spec",
+			want:     [][]string{{"metadata", "etag"}},
+		},
+		"partial wildcard": {
+			location: "meta*",
+			name:     "metadata",
+			want:     [][]string{{}},
+		},
+		"character class": {
+			location: "condition[s]",
+			name:     "conditions",
+			want:     [][]string{{}},
+		},
+		"any attributes wildcard - nested": {
+			location: "**.links",
+			name:     "spec",
+			want:     [][]string{{"**", "links"}},
+		},
+		"any attributes wildcard - attribute": {
+			location: "**.links",
+			name:     "links",
+			want:     [][]string{{"**", "links"}, {}},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.MatchAttributeName(strings.Split(testCase.location, "."), testCase.name)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
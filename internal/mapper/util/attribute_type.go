@@ -15,6 +15,11 @@ func CreateObjectAttributeType(name string, elemType schema.ElementType) schema.
 		attrType.Bool = elemType.Bool
 	case elemType.Float64 != nil:
 		attrType.Float64 = elemType.Float64
+	case elemType.Int32 != nil:
+		// Object attribute types do not support Int32, so the type is widened to Int64
+		attrType.Int64 = &schema.Int64Type{
+			CustomType: elemType.Int32.CustomType,
+		}
 	case elemType.Int64 != nil:
 		attrType.Int64 = elemType.Int64
 	case elemType.List != nil:
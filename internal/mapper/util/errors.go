@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+// FlattenErrors returns each error joined with errors.Join, including errors in nested joins, or the error itself if it wasn't
+// joined. Returns nil if err is nil.
+func FlattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	joinedErr, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	var result []error
+	for _, e := range joinedErr.Unwrap() {
+		result = append(result, FlattenErrors(e)...)
+	}
+
+	return result
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+func TestFlattenErrors(t *testing.T) {
+	t.Parallel()
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := errors.New("c")
+	wrappedErr := fmt.Errorf("wrapped: %w", errors.Join(errB, errC))
+
+	testCases := map[string]struct {
+		err  error
+		want []error
+	}{
+		"nil": {
+			err:  nil,
+			want: nil,
+		},
+		"single error": {
+			err:  errA,
+			want: []error{errA},
+		},
+		"joined errors": {
+			err:  errors.Join(errA, errB),
+			want: []error{errA, errB},
+		},
+		"nested joined errors": {
+			err:  errors.Join(errA, errors.Join(errB, errC)),
+			want: []error{errA, errB, errC},
+		},
+		"wrapped joined errors are kept": {
+			err:  errors.Join(errA, wrappedErr),
+			want: []error{errA, wrappedErr},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.FlattenErrors(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.want, cmp.Comparer(func(x, y error) bool { return x == y })); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ const (
 
 	OAS_format_double   = "double"
 	OAS_format_float    = "float"
+	OAS_format_int32    = "int32"
+	OAS_format_int64    = "int64"
 	OAS_format_password = "password"
 
 	OAS_param_path  = "path"
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import "regexp"
+
+// pathParameterRegex matches a path template parameter, i.e. `{widgetId}` in `/widgets/{widgetId}`
+var pathParameterRegex = regexp.MustCompile(`\{([^{}/]+)\}`)
+
+// PathParameters returns the names of all parameters in an OpenAPI path template, in the order they appear.
+//
+//   - PathParameters("/org/{org_id}/users/{id}") = [org_id id]
+//   - PathParameters("/users") = []
+func PathParameters(path string) []string {
+	params := make([]string, 0)
+
+	for _, match := range pathParameterRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, match[1])
+	}
+
+	return params
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+func TestPathParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path string
+		want []string
+	}{
+		"no parameters": {
+			path: "/widgets",
+			want: []string{},
+		},
+		"one parameter": {
+			path: "/widgets/{widgetId}",
+			want: []string{"widgetId"},
+		},
+		"multiple parameters": {
+			path: "/org/{org_id}/users/{id}",
+			want: []string{"org_id", "id"},
+		},
+		"parameter within a segment": {
+			path: "/files/{name}.{ext}",
+			want: []string{"name", "ext"},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.PathParameters(testCase.path)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swagger
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+const (
+	// openAPIVersion is the OpenAPI version set on converted documents
+	openAPIVersion = "3.0.3"
+
+	paramInBody     = "body"
+	paramInFormData = "formData"
+
+	paramTypeFile = "file"
+
+	mediaTypeFormURLEncoded = "application/x-www-form-urlencoded"
+	mediaTypeMultipartForm  = "multipart/form-data"
+)
+
+// ConvertDocument converts a Swagger 2.0 model into an OpenAPI 3.x model:
+//   - `definitions`, `parameters`, `responses`, and `securityDefinitions` are moved to `components`
+//   - `host`, `basePath`, and `schemes` are converted to `servers`
+//   - `in: body` parameters are converted to a `requestBody`, using the `consumes` media types of the operation
+//   - `in: formData` parameters are converted to an object schema in a `requestBody`, using the form media types
+//   - Response `schema` is moved to `content`, using the `produces` media types of the operation
+//   - Parameter types, formats and validations are moved to the parameter `schema`
+//
+// Schemas are shared between both models, so any `$ref` in a schema still points to `#/definitions`, which are
+// resolved with the index of the Swagger 2.0 document. Response headers and examples are not converted, as they
+// are not used for mapping.
+func ConvertDocument(model *libopenapi.DocumentModel[v2high.Swagger]) *high.Document {
+	swagger := model.Model
+
+	doc := &high.Document{
+		Version:      openAPIVersion,
+		Info:         swagger.Info,
+		Servers:      convertServers(swagger),
+		Security:     swagger.Security,
+		Tags:         swagger.Tags,
+		ExternalDocs: swagger.ExternalDocs,
+		Extensions:   swagger.Extensions,
+		Components:   convertComponents(swagger),
+		Index:        model.Index,
+	}
+
+	if swagger.Paths == nil {
+		return doc
+	}
+
+	doc.Paths = &high.Paths{
+		PathItems:  orderedmap.New[string, *high.PathItem](),
+		Extensions: swagger.Paths.Extensions,
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), swagger.Paths.PathItems) {
+		doc.Paths.PathItems.Set(pair.Key(), convertPathItem(swagger, pair.Value()))
+	}
+
+	return doc
+}
+
+// convertServers builds a server for each scheme, defaulting to `https` if no schemes are defined.
+func convertServers(swagger v2high.Swagger) []*high.Server {
+	if swagger.Host == "" {
+		if swagger.BasePath == "" {
+			return nil
+		}
+		return []*high.Server{{URL: swagger.BasePath}}
+	}
+
+	schemes := swagger.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	servers := make([]*high.Server, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, &high.Server{
+			URL: fmt.Sprintf("%s://%s%s", scheme, swagger.Host, swagger.BasePath),
+		})
+	}
+
+	return servers
+}
+
+func convertComponents(swagger v2high.Swagger) *high.Components {
+	components := &high.Components{
+		Schemas:         orderedmap.New[string, *base.SchemaProxy](),
+		Parameters:      orderedmap.New[string, *high.Parameter](),
+		Responses:       orderedmap.New[string, *high.Response](),
+		SecuritySchemes: orderedmap.New[string, *high.SecurityScheme](),
+	}
+
+	if swagger.Definitions != nil {
+		components.Schemas = swagger.Definitions.Definitions
+	}
+
+	if swagger.Parameters != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), swagger.Parameters.Definitions) {
+			// Body and form parameters have no equivalent component in OpenAPI 3.x, they will be converted where they are used
+			if param := convertParameter(pair.Value()); param != nil {
+				components.Parameters.Set(pair.Key(), param)
+			}
+		}
+	}
+
+	if swagger.Responses != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), swagger.Responses.Definitions) {
+			components.Responses.Set(pair.Key(), convertResponse(pair.Value(), swagger.Produces))
+		}
+	}
+
+	if swagger.SecurityDefinitions != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), swagger.SecurityDefinitions.Definitions) {
+			components.SecuritySchemes.Set(pair.Key(), convertSecurityScheme(pair.Value()))
+		}
+	}
+
+	return components
+}
+
+func convertSecurityScheme(scheme *v2high.SecurityScheme) *high.SecurityScheme {
+	if scheme == nil {
+		return nil
+	}
+
+	converted := &high.SecurityScheme{
+		Type:        scheme.Type,
+		Description: scheme.Description,
+		Name:        scheme.Name,
+		In:          scheme.In,
+		Extensions:  scheme.Extensions,
+	}
+
+	// Basic authentication is an HTTP authentication scheme in OpenAPI 3.x
+	if scheme.Type == "basic" {
+		converted.Type = "http"
+		converted.Scheme = "basic"
+	}
+
+	return converted
+}
+
+func convertPathItem(swagger v2high.Swagger, pathItem *v2high.PathItem) *high.PathItem {
+	if pathItem == nil {
+		return nil
+	}
+
+	converted := &high.PathItem{
+		Extensions: pathItem.Extensions,
+	}
+
+	// Body and form parameters can't be defined on an OpenAPI 3.x path item, so they are added to each operation instead
+	var pathBodyParams []*v2high.Parameter
+	for _, param := range pathItem.Parameters {
+		if isBodyParameter(param) {
+			pathBodyParams = append(pathBodyParams, param)
+			continue
+		}
+
+		if convertedParam := convertParameter(param); convertedParam != nil {
+			converted.Parameters = append(converted.Parameters, convertedParam)
+		}
+	}
+
+	convertOp := func(op *v2high.Operation) *high.Operation {
+		return convertOperation(swagger, op, pathBodyParams)
+	}
+
+	converted.Get = convertOp(pathItem.Get)
+	converted.Put = convertOp(pathItem.Put)
+	converted.Post = convertOp(pathItem.Post)
+	converted.Delete = convertOp(pathItem.Delete)
+	converted.Options = convertOp(pathItem.Options)
+	converted.Head = convertOp(pathItem.Head)
+	converted.Patch = convertOp(pathItem.Patch)
+
+	return converted
+}
+
+func convertOperation(swagger v2high.Swagger, op *v2high.Operation, pathBodyParams []*v2high.Parameter) *high.Operation {
+	if op == nil {
+		return nil
+	}
+
+	converted := &high.Operation{
+		Tags:         op.Tags,
+		Summary:      op.Summary,
+		Description:  op.Description,
+		ExternalDocs: op.ExternalDocs,
+		OperationId:  op.OperationId,
+		Security:     op.Security,
+		Extensions:   op.Extensions,
+	}
+
+	if op.Deprecated {
+		converted.Deprecated = &op.Deprecated
+	}
+
+	// Operation body and form parameters override path item body and form parameters with the same name
+	var bodyParams []*v2high.Parameter
+	for _, param := range op.Parameters {
+		if isBodyParameter(param) {
+			bodyParams = append(bodyParams, param)
+			continue
+		}
+
+		if convertedParam := convertParameter(param); convertedParam != nil {
+			converted.Parameters = append(converted.Parameters, convertedParam)
+		}
+	}
+	for _, pathParam := range pathBodyParams {
+		overridden := slices.ContainsFunc(bodyParams, func(param *v2high.Parameter) bool {
+			return param.Name == pathParam.Name && param.In == pathParam.In
+		})
+		if !overridden {
+			bodyParams = append(bodyParams, pathParam)
+		}
+	}
+
+	consumes := op.Consumes
+	if len(consumes) == 0 {
+		consumes = swagger.Consumes
+	}
+	converted.RequestBody = convertRequestBody(bodyParams, consumes)
+
+	produces := op.Produces
+	if len(produces) == 0 {
+		produces = swagger.Produces
+	}
+	converted.Responses = convertResponses(op.Responses, produces)
+
+	return converted
+}
+
+// convertRequestBody converts a body parameter, or all form parameters, into a request body. Returns nil if there
+// are no body or form parameters.
+func convertRequestBody(params []*v2high.Parameter, consumes []string) *high.RequestBody {
+	if len(params) == 0 {
+		return nil
+	}
+
+	// There can only be one body parameter, which can't be combined with form parameters
+	for _, param := range params {
+		if param.In != paramInBody {
+			continue
+		}
+
+		return &high.RequestBody{
+			Description: param.Description,
+			Required:    param.Required,
+			Content:     mediaTypeContent(param.Schema, consumes, []string{util.OAS_mediatype_json}),
+			Extensions:  param.Extensions,
+		}
+	}
+
+	formSchema := &base.Schema{
+		Type:       []string{util.OAS_type_object},
+		Properties: orderedmap.New[string, *base.SchemaProxy](),
+	}
+
+	formMediaType := mediaTypeFormURLEncoded
+	required := false
+	for _, param := range params {
+		if param.Type == paramTypeFile {
+			formMediaType = mediaTypeMultipartForm
+		}
+
+		if param.Required != nil && *param.Required {
+			formSchema.Required = append(formSchema.Required, param.Name)
+			required = true
+		}
+
+		propSchema := parameterSchema(param)
+		propSchema.Description = param.Description
+		propSchema.Extensions = param.Extensions
+
+		formSchema.Properties.Set(param.Name, base.CreateSchemaProxy(propSchema))
+	}
+
+	formConsumes := slices.DeleteFunc(slices.Clone(consumes), func(mediaType string) bool {
+		return mediaType != mediaTypeFormURLEncoded && mediaType != mediaTypeMultipartForm
+	})
+
+	return &high.RequestBody{
+		Required: &required,
+		Content:  mediaTypeContent(base.CreateSchemaProxy(formSchema), formConsumes, []string{formMediaType}),
+	}
+}
+
+func convertResponses(responses *v2high.Responses, produces []string) *high.Responses {
+	if responses == nil {
+		return nil
+	}
+
+	converted := &high.Responses{
+		Codes:      orderedmap.New[string, *high.Response](),
+		Extensions: responses.Extensions,
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), responses.Codes) {
+		converted.Codes.Set(pair.Key(), convertResponse(pair.Value(), produces))
+	}
+
+	if responses.Default != nil {
+		converted.Default = convertResponse(responses.Default, produces)
+	}
+
+	return converted
+}
+
+func convertResponse(response *v2high.Response, produces []string) *high.Response {
+	if response == nil {
+		return nil
+	}
+
+	converted := &high.Response{
+		Description: response.Description,
+		Extensions:  response.Extensions,
+	}
+
+	if response.Schema != nil {
+		converted.Content = mediaTypeContent(response.Schema, produces, []string{util.OAS_mediatype_json})
+	}
+
+	return converted
+}
+
+// mediaTypeContent creates a media type for each of the given media types with the same schema, using the default media
+// types if none are given.
+func mediaTypeContent(schema *base.SchemaProxy, mediaTypes []string, defaultMediaTypes []string) *orderedmap.Map[string, *high.MediaType] {
+	if len(mediaTypes) == 0 {
+		mediaTypes = defaultMediaTypes
+	}
+
+	content := orderedmap.New[string, *high.MediaType]()
+	for _, mediaType := range mediaTypes {
+		content.Set(mediaType, &high.MediaType{Schema: schema})
+	}
+
+	return content
+}
+
+// convertParameter converts a path, query, header, or cookie parameter, moving the type, format, and validations into
+// the parameter schema. Returns nil for body and form parameters, which are converted to a request body.
+func convertParameter(param *v2high.Parameter) *high.Parameter {
+	if param == nil || isBodyParameter(param) {
+		return nil
+	}
+
+	return &high.Parameter{
+		Name:        param.Name,
+		In:          param.In,
+		Description: param.Description,
+		Required:    param.Required,
+		Schema:      base.CreateSchemaProxy(parameterSchema(param)),
+		Extensions:  param.Extensions,
+	}
+}
+
+func isBodyParameter(param *v2high.Parameter) bool {
+	return param != nil && (param.In == paramInBody || param.In == paramInFormData)
+}
+
+// parameterSchema builds a schema from the type, format, and validations of a non-body parameter.
+func parameterSchema(param *v2high.Parameter) *base.Schema {
+	s := &base.Schema{
+		Default:     param.Default,
+		Enum:        param.Enum,
+		Pattern:     param.Pattern,
+		UniqueItems: param.UniqueItems,
+	}
+	setSchemaType(s, param.Type, param.Format)
+
+	if param.Maximum != nil {
+		s.Maximum = float64Pointer(*param.Maximum)
+		if param.ExclusiveMaximum != nil && *param.ExclusiveMaximum {
+			s.ExclusiveMaximum = &base.DynamicValue[bool, float64]{A: true}
+		}
+	}
+	if param.Minimum != nil {
+		s.Minimum = float64Pointer(*param.Minimum)
+		if param.ExclusiveMinimum != nil && *param.ExclusiveMinimum {
+			s.ExclusiveMinimum = &base.DynamicValue[bool, float64]{A: true}
+		}
+	}
+	if param.MultipleOf != nil {
+		s.MultipleOf = float64Pointer(*param.MultipleOf)
+	}
+	if param.MaxLength != nil {
+		s.MaxLength = int64Pointer(*param.MaxLength)
+	}
+	if param.MinLength != nil {
+		s.MinLength = int64Pointer(*param.MinLength)
+	}
+	if param.MaxItems != nil {
+		s.MaxItems = int64Pointer(*param.MaxItems)
+	}
+	if param.MinItems != nil {
+		s.MinItems = int64Pointer(*param.MinItems)
+	}
+
+	if param.Items != nil {
+		s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(itemsSchema(param.Items))}
+	}
+
+	return s
+}
+
+// itemsSchema builds a schema from the items of an array parameter.
+func itemsSchema(items *v2high.Items) *base.Schema {
+	s := &base.Schema{
+		Default: items.Default,
+		Enum:    items.Enum,
+		Pattern: items.Pattern,
+	}
+	setSchemaType(s, items.Type, items.Format)
+
+	if items.Items != nil {
+		s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(itemsSchema(items.Items))}
+	}
+
+	return s
+}
+
+// setSchemaType sets the type and format of a schema, converting the `file` type to a binary string.
+func setSchemaType(s *base.Schema, paramType, format string) {
+	if strings.EqualFold(paramType, paramTypeFile) {
+		s.Type = []string{util.OAS_type_string}
+		s.Format = "binary"
+		return
+	}
+
+	if paramType != "" {
+		s.Type = []string{paramType}
+	}
+	s.Format = format
+}
+
+func float64Pointer(value int) *float64 {
+	f := float64(value)
+	return &f
+}
+
+func int64Pointer(value int) *int64 {
+	i := int64(value)
+	return &i
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swagger_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+
+	"github.com/greatman/terraform-plugin-codegen-openapi/internal/swagger"
+)
+
+const testSwagger = `swagger: "2.0"
+info:
+  title: Test API
+  version: 1.0.0
+host: api.example.com
+basePath: /v2
+schemes:
+  - http
+  - https
+produces:
+  - application/json
+  - application/xml
+securityDefinitions:
+  basic_auth:
+    type: basic
+  api_key:
+    type: apiKey
+    name: X-API-Key
+    in: header
+paths:
+  /things:
+    parameters:
+      - name: dry_run
+        in: formData
+        type: boolean
+    post:
+      operationId: createThing
+      consumes:
+        - multipart/form-data
+      parameters:
+        - name: name
+          in: formData
+          required: true
+          type: string
+        - name: attachment
+          in: formData
+          type: file
+        - name: x-request-id
+          in: header
+          type: string
+      responses:
+        "201":
+          description: Created
+          schema:
+            $ref: "#/definitions/Thing"
+  /things/{thing_id}:
+    parameters:
+      - name: thing_id
+        in: path
+        required: true
+        type: integer
+        format: int64
+        minimum: 1
+    put:
+      operationId: updateThing
+      deprecated: true
+      consumes:
+        - application/json
+        - application/merge-patch+json
+      parameters:
+        - name: body
+          in: body
+          description: The thing to update
+          required: true
+          schema:
+            $ref: "#/definitions/Thing"
+        - name: tags
+          in: query
+          type: array
+          maxItems: 5
+          items:
+            type: string
+            enum: [a, b]
+      responses:
+        "200":
+          description: OK
+          schema:
+            $ref: "#/definitions/Thing"
+        default:
+          description: Error
+definitions:
+  Thing:
+    type: object
+    properties:
+      name:
+        type: string
+`
+
+func TestConvertDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := libopenapi.NewDocument([]byte(testSwagger))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test spec: %s", err)
+	}
+
+	model, errs := doc.BuildV2Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test spec: %v", errs)
+	}
+
+	converted := swagger.ConvertDocument(model)
+
+	t.Run("servers", func(t *testing.T) {
+		t.Parallel()
+
+		var got []string
+		for _, server := range converted.Servers {
+			got = append(got, server.URL)
+		}
+
+		if diff := cmp.Diff(got, []string{"http://api.example.com/v2", "https://api.example.com/v2"}); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("components", func(t *testing.T) {
+		t.Parallel()
+
+		if _, ok := converted.Components.Schemas.Get("Thing"); !ok {
+			t.Errorf("expected 'Thing' definition to be converted to a component schema")
+		}
+
+		basicAuth, _ := converted.Components.SecuritySchemes.Get("basic_auth")
+		if diff := cmp.Diff([]string{basicAuth.Type, basicAuth.Scheme}, []string{"http", "basic"}); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+
+		apiKey, _ := converted.Components.SecuritySchemes.Get("api_key")
+		if diff := cmp.Diff([]string{apiKey.Type, apiKey.Name, apiKey.In}, []string{"apiKey", "X-API-Key", "header"}); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("form parameters", func(t *testing.T) {
+		t.Parallel()
+
+		op := converted.Paths.PathItems.GetOrZero("/things").Post
+
+		if diff := cmp.Diff(parameterLocations(op.Parameters), []string{"header:x-request-id"}); diff != "" {
+			t.Errorf("unexpected parameters difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(mediaTypes(op.RequestBody.Content), []string{"multipart/form-data"}); diff != "" {
+			t.Errorf("unexpected media types difference: %s", diff)
+		}
+
+		formSchema := op.RequestBody.Content.GetOrZero("multipart/form-data").Schema.Schema()
+		if diff := cmp.Diff(formSchema.Required, []string{"name"}); diff != "" {
+			t.Errorf("unexpected required difference: %s", diff)
+		}
+
+		var got []string
+		for pair := range orderedmap.Iterate(context.TODO(), formSchema.Properties) {
+			propSchema := pair.Value().Schema()
+			got = append(got, pair.Key()+":"+propSchema.Type[0]+":"+propSchema.Format)
+		}
+
+		// Path item form parameters are added to each operation
+		want := []string{"name:string:", "attachment:string:binary", "dry_run:boolean:"}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected form properties difference: %s", diff)
+		}
+	})
+
+	t.Run("body parameter", func(t *testing.T) {
+		t.Parallel()
+
+		pathItem := converted.Paths.PathItems.GetOrZero("/things/{thing_id}")
+		op := pathItem.Put
+
+		if op.Deprecated == nil || !*op.Deprecated {
+			t.Errorf("expected operation to be deprecated")
+		}
+
+		if diff := cmp.Diff(parameterLocations(pathItem.Parameters), []string{"path:thing_id"}); diff != "" {
+			t.Errorf("unexpected path item parameters difference: %s", diff)
+		}
+
+		thingIdSchema := pathItem.Parameters[0].Schema.Schema()
+		if diff := cmp.Diff([]any{thingIdSchema.Type, thingIdSchema.Format, *thingIdSchema.Minimum}, []any{[]string{"integer"}, "int64", float64(1)}); diff != "" {
+			t.Errorf("unexpected path parameter schema difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(parameterLocations(op.Parameters), []string{"query:tags"}); diff != "" {
+			t.Errorf("unexpected parameters difference: %s", diff)
+		}
+
+		tagsSchema := op.Parameters[0].Schema.Schema()
+		tagsItemsSchema := tagsSchema.Items.A.Schema()
+		if diff := cmp.Diff([]any{tagsSchema.Type, *tagsSchema.MaxItems, tagsItemsSchema.Type, len(tagsItemsSchema.Enum)}, []any{[]string{"array"}, int64(5), []string{"string"}, 2}); diff != "" {
+			t.Errorf("unexpected query parameter schema difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(op.RequestBody.Description, "The thing to update"); diff != "" {
+			t.Errorf("unexpected request body description difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(mediaTypes(op.RequestBody.Content), []string{"application/json", "application/merge-patch+json"}); diff != "" {
+			t.Errorf("unexpected request body media types difference: %s", diff)
+		}
+	})
+
+	t.Run("responses", func(t *testing.T) {
+		t.Parallel()
+
+		responses := converted.Paths.PathItems.GetOrZero("/things/{thing_id}").Put.Responses
+
+		okResponse := responses.Codes.GetOrZero("200")
+		if diff := cmp.Diff(mediaTypes(okResponse.Content), []string{"application/json", "application/xml"}); diff != "" {
+			t.Errorf("unexpected response media types difference: %s", diff)
+		}
+
+		if okResponse.Content.GetOrZero("application/json").Schema.Schema().Properties.Len() != 1 {
+			t.Errorf("expected response schema to resolve the 'Thing' definition")
+		}
+
+		if responses.Default == nil || responses.Default.Content != nil {
+			t.Errorf("expected default response without content, got: %v", responses.Default)
+		}
+	})
+}
+
+func parameterLocations(params []*high.Parameter) []string {
+	var locations []string
+	for _, param := range params {
+		locations = append(locations, param.In+":"+param.Name)
+	}
+
+	return locations
+}
+
+func mediaTypes(content *orderedmap.Map[string, *high.MediaType]) []string {
+	var mediaTypes []string
+	for pair := range orderedmap.Iterate(context.TODO(), content) {
+		mediaTypes = append(mediaTypes, pair.Key())
+	}
+
+	return mediaTypes
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package swagger converts Swagger 2.0 (OpenAPI 2.0) models into OpenAPI 3.x models, so they can be explored and mapped
+// into Provider Code Specification the same as an OpenAPI 3.x specification.
+package swagger